@@ -0,0 +1,51 @@
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/mitsu-yuki/shisho-backend/internal/api"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/reading"
+	"github.com/mitsu-yuki/shisho-backend/internal/infrastructure/mecab"
+	"github.com/mitsu-yuki/shisho-backend/internal/infrastructure/postgres"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "待ち受けるアドレス")
+	mecabPath := flag.String("mecab", "", "読みの推定に使うMeCabのコマンド。指定しなければ同梱の辞書のみを使う")
+	flag.Parse()
+
+	if err := run(*addr, *mecabPath); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(addr string, mecabPath string) error {
+	conn, err := sql.Open("pgx", os.Getenv("DATABASE_URL"))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var suggester reading.Suggester = reading.NewDictionary(reading.WithBundledDictionary())
+	if mecabPath != "" {
+		suggester = reading.Chain(mecab.NewSuggester(mecabPath), suggester)
+	}
+
+	router := api.NewRouter(
+		postgres.NewAuthorRepository(conn),
+		postgres.NewLabelRepository(conn),
+		postgres.NewPublishRepository(conn),
+		suggester,
+		postgres.NewCandidateRepository(conn),
+		postgres.NewDuplicateMerger(conn),
+		clock.New(),
+	)
+	return http.ListenAndServe(addr, router)
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/duplicate"
+	"github.com/mitsu-yuki/shisho-backend/internal/infrastructure/postgres"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+)
+
+func main() {
+	interval := flag.Duration("interval", 0, "この間隔で検出を繰り返す。0なら1回だけ検出して終了する")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := run(ctx, *interval); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, interval time.Duration) error {
+	if interval < 0 {
+		return fmt.Errorf("invalid interval: %s", interval)
+	}
+
+	conn, err := sql.Open("pgx", os.Getenv("DATABASE_URL"))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if interval == 0 {
+		return detect(ctx, conn)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := detect(ctx, conn); err != nil {
+			// 常駐している間は次の検出で回復する可能性があるので止めない
+			fmt.Fprintln(os.Stderr, err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// detect は論理削除されていない書籍、著者、レーベル、出版社から重複の候補を探し、未対応の候補を入れ替える
+func detect(ctx context.Context, conn *sql.DB) error {
+	clk := clock.New()
+	books, err := postgres.NewBookRepository(conn).FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("find book: %w", err)
+	}
+	authors, err := postgres.NewAuthorRepository(conn).FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("find author: %w", err)
+	}
+	labels, err := postgres.NewLabelRepository(conn).FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("find label: %w", err)
+	}
+	publishes, err := postgres.NewPublishRepository(conn).FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("find publish: %w", err)
+	}
+
+	found := map[duplicate.Kind][]*duplicate.Candidate{
+		duplicate.KindBook:    duplicate.FindBooks(clk, books),
+		duplicate.KindAuthor:  duplicate.FindNamed(clk, duplicate.KindAuthor, authors),
+		duplicate.KindLabel:   duplicate.FindNamed(clk, duplicate.KindLabel, labels),
+		duplicate.KindPublish: duplicate.FindNamed(clk, duplicate.KindPublish, publishes),
+	}
+	candidates := postgres.NewCandidateRepository(conn)
+	for _, kind := range duplicate.Kinds {
+		if err := candidates.ReplacePending(ctx, kind, found[kind]); err != nil {
+			return fmt.Errorf("replace %s: %w", kind, err)
+		}
+		fmt.Printf("detected %s\t%d\n", kind, len(found[kind]))
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bufio"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// main は International ISBN Agency の RangeMessage.xml から internal/domain/isbn/ranges.tsv を作る
+// RangeMessage.xml は https://www.isbn-international.org/range_file_generation から取得する
+func main() {
+	in := flag.String("in", "RangeMessage.xml", "RangeMessage.xml のパス")
+	out := flag.String("out", "ranges.tsv", "出力先のパス")
+	flag.Parse()
+
+	if err := run(*in, *out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+type rangeMessage struct {
+	Source   string  `xml:"MessageSource"`
+	Serial   string  `xml:"MessageSerialNumber"`
+	Date     string  `xml:"MessageDate"`
+	Prefixes []group `xml:"EAN.UCCPrefixes>EAN.UCC"`
+	Groups   []group `xml:"RegistrationGroups>Group"`
+}
+
+type group struct {
+	Prefix string `xml:"Prefix"`
+	Agency string `xml:"Agency"`
+	Rules  []rule `xml:"Rules>Rule"`
+}
+
+type rule struct {
+	Range  string `xml:"Range"`
+	Length int    `xml:"Length"`
+}
+
+func run(in, out string) error {
+	r, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	var msg rangeMessage
+	if err := xml.NewDecoder(r).Decode(&msg); err != nil {
+		return fmt.Errorf("decode %s: %w", in, err)
+	}
+
+	w, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	if err := write(bw, msg); err != nil {
+		w.Close()
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func write(w io.Writer, msg rangeMessage) error {
+	fmt.Fprintf(w, "# ISBNの範囲データ\n")
+	fmt.Fprintf(w, "# %s の RangeMessage.xml (%s, %s) から cmd/isbnranges で作った。手で編集しない\n", msg.Source, msg.Serial, msg.Date)
+	fmt.Fprintf(w, "# 接頭辞に続く7桁が 開始〜終了 に入るとき、次の要素を 桁数 で区切る\n")
+	fmt.Fprintf(w, "# 接頭辞が978/979のみの行は登録グループ、978-4 のような行はそのグループの出版者記号の範囲\n")
+	fmt.Fprintf(w, "#\n")
+	fmt.Fprintf(w, "# 接頭辞\t開始\t終了\t桁数\n")
+	for _, g := range append(msg.Prefixes, msg.Groups...) {
+		fmt.Fprintf(w, "# %s\n", g.Agency)
+		for _, r := range g.Rules {
+			// 桁数0は未割り当ての範囲なので、行がない場合と同じく区切れないものとして除く
+			if r.Length == 0 {
+				continue
+			}
+			start, end, ok := strings.Cut(r.Range, "-")
+			if !ok {
+				return fmt.Errorf("%s: invalid range %q", g.Prefix, r.Range)
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", g.Prefix, start, end, r.Length); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/mitsu-yuki/shisho-backend/db"
+	"github.com/mitsu-yuki/shisho-backend/internal/infrastructure/postgres/migration"
+)
+
+const usage = `usage: migrate <command> [args]
+
+commands:
+  up              未適用のマイグレーションをすべて適用する
+  down [steps]    適用済みのマイグレーションを新しいものから戻す(デフォルト: 1)
+  status          マイグレーションの適用状況を表示する
+  baseline <ver>  ver までを適用済みとして記録する
+
+接続先は環境変数 DATABASE_URL で指定する`
+
+func main() {
+	if err := run(context.Background(), os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%s", usage)
+	}
+
+	conn, err := sql.Open("pgx", os.Getenv("DATABASE_URL"))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	migrator, err := migration.NewMigrator(conn, db.Migrations, "migrations")
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		done, err := migrator.Up(ctx)
+		printMigrations("applied", done)
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid steps: %s", args[1])
+			}
+		}
+		done, err := migrator.Down(ctx, steps)
+		printMigrations("reverted", done)
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+		return nil
+	case "baseline":
+		if len(args) < 2 {
+			return fmt.Errorf("%s", usage)
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid version: %s", args[1])
+		}
+		return migrator.Baseline(ctx, version)
+	default:
+		return fmt.Errorf("%s", usage)
+	}
+}
+
+func printMigrations(action string, migrations []migration.Migration) {
+	for _, m := range migrations {
+		fmt.Printf("%s %04d_%s\n", action, m.Version, m.Name)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/mitsu-yuki/shisho-backend/internal/infrastructure/postgres"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+)
+
+// defaultRetention は論理削除した行を残しておく期間
+const defaultRetention = 30 * 24 * time.Hour
+
+type purger interface {
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+// unpurgedCounter は参照されているため Purge で残した行を数えられるリポジトリ
+type unpurgedCounter interface {
+	CountUnpurged(ctx context.Context, before time.Time) (int64, error)
+}
+
+func main() {
+	retention := flag.Duration("retention", defaultRetention, "論理削除してからこの期間を過ぎた行を物理削除する")
+	flag.Parse()
+
+	if err := run(context.Background(), *retention); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, retention time.Duration) error {
+	if retention < 0 {
+		return fmt.Errorf("invalid retention: %s", retention)
+	}
+
+	conn, err := sql.Open("pgx", os.Getenv("DATABASE_URL"))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// 参照する側から先に消す
+	targets := []struct {
+		name string
+		repo purger
+	}{
+		{"series", postgres.NewSeriesRepository(conn)},
+		{"book", postgres.NewBookRepository(conn)},
+		{"tag", postgres.NewTagRepository(conn)},
+		{"author", postgres.NewAuthorRepository(conn)},
+		{"label", postgres.NewLabelRepository(conn)},
+		{"publish", postgres.NewPublishRepository(conn)},
+		{"size", postgres.NewSizeRepository(conn)},
+	}
+
+	before := clock.New().Now().Add(-retention)
+	return postgres.NewTransaction(conn).Do(ctx, func(ctx context.Context) error {
+		for _, target := range targets {
+			purged, err := target.repo.Purge(ctx, before)
+			if err != nil {
+				return fmt.Errorf("purge %s: %w", target.name, err)
+			}
+			fmt.Printf("purged %s\t%d\n", target.name, purged)
+			if counter, ok := target.repo.(unpurgedCounter); ok {
+				kept, err := counter.CountUnpurged(ctx, before)
+				if err != nil {
+					return fmt.Errorf("count unpurged %s: %w", target.name, err)
+				}
+				fmt.Printf("kept %s\t%d\n", target.name, kept)
+			}
+		}
+		return nil
+	})
+}
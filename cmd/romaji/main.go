@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/internal/infrastructure/postgres"
+)
+
+// main は既存の著者、レーベル、出版社を保存し直して、0010 のマイグレーションで追加したローマ字の列を埋める
+// ローマ字は保存するときに読みから作るため、一度埋めた後は実行しなくてよい
+func main() {
+	if err := run(context.Background()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	conn, err := sql.Open("pgx", os.Getenv("DATABASE_URL"))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	authors := postgres.NewAuthorRepository(conn)
+	labels := postgres.NewLabelRepository(conn)
+	publishes := postgres.NewPublishRepository(conn)
+	return postgres.NewTransaction(conn).Do(ctx, func(ctx context.Context) error {
+		all, err := authors.FindAll(ctx, repository.WithDeleted())
+		if err != nil {
+			return fmt.Errorf("find author: %w", err)
+		}
+		for _, a := range all {
+			if err := authors.Save(ctx, a); err != nil {
+				return fmt.Errorf("save author %s: %w", a.ID(), err)
+			}
+		}
+		fmt.Printf("updated author\t%d\n", len(all))
+
+		allLabels, err := labels.FindAll(ctx, repository.WithDeleted())
+		if err != nil {
+			return fmt.Errorf("find label: %w", err)
+		}
+		for _, l := range allLabels {
+			if err := labels.Save(ctx, l); err != nil {
+				return fmt.Errorf("save label %s: %w", l.ID(), err)
+			}
+		}
+		fmt.Printf("updated label\t%d\n", len(allLabels))
+
+		allPublishes, err := publishes.FindAll(ctx, repository.WithDeleted())
+		if err != nil {
+			return fmt.Errorf("find publish: %w", err)
+		}
+		for _, p := range allPublishes {
+			if err := publishes.Save(ctx, p); err != nil {
+				return fmt.Errorf("save publish %s: %w", p.ID(), err)
+			}
+		}
+		fmt.Printf("updated publish\t%d\n", len(allPublishes))
+		return nil
+	})
+}
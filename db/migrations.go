@@ -0,0 +1,8 @@
+package db
+
+import "embed"
+
+// Migrations は番号付きのup/downマイグレーションファイル
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
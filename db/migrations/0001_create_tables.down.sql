@@ -0,0 +1,21 @@
+DROP TABLE IF EXISTS "tag_list";
+
+DROP TABLE IF EXISTS "tag";
+
+DROP TABLE IF EXISTS "series_list";
+
+DROP TABLE IF EXISTS "series_title";
+
+DROP TABLE IF EXISTS "series_status";
+
+DROP TABLE IF EXISTS "author_list";
+
+DROP TABLE IF EXISTS "book";
+
+DROP TABLE IF EXISTS "book_size";
+
+DROP TABLE IF EXISTS "publish";
+
+DROP TABLE IF EXISTS "creator";
+
+DROP TABLE IF EXISTS "book_label";
@@ -0,0 +1,95 @@
+-- アプリケーションが発行したULIDやINTに収まらないISBNは戻せないため、このマイグレーション以前のデータのみ戻すことができる
+
+ALTER TABLE "book" DROP CONSTRAINT "book_label_id_fkey";
+
+ALTER TABLE "book" DROP CONSTRAINT "book_publish_id_fkey";
+
+ALTER TABLE "book" DROP CONSTRAINT "book_size_id_fkey";
+
+ALTER TABLE "author_list" DROP CONSTRAINT "author_list_book_id_fkey";
+
+ALTER TABLE "author_list" DROP CONSTRAINT "author_list_creator_id_fkey";
+
+ALTER TABLE "series_list" DROP CONSTRAINT "series_list_title_id_fkey";
+
+ALTER TABLE "series_list" DROP CONSTRAINT "series_list_book_id_fkey";
+
+ALTER TABLE "series_title" DROP CONSTRAINT "series_title_status_id_fkey";
+
+ALTER TABLE "tag_list" DROP CONSTRAINT "tag_list_book_id_fkey";
+
+ALTER TABLE "tag_list" DROP CONSTRAINT "tag_list_tag_id_fkey";
+
+ALTER TABLE "book" ALTER COLUMN "book_isbn" TYPE int USING "book_isbn"::int;
+
+ALTER TABLE "book" ALTER COLUMN "label_id" TYPE int USING "label_id"::int;
+
+ALTER TABLE "book" ALTER COLUMN "publish_id" TYPE int USING "publish_id"::int;
+
+ALTER TABLE "book" ALTER COLUMN "size_id" TYPE int USING "size_id"::int;
+
+ALTER TABLE "author_list" ALTER COLUMN "book_id" TYPE int USING "book_id"::int;
+
+ALTER TABLE "author_list" ALTER COLUMN "creator_id" TYPE int USING "creator_id"::int;
+
+ALTER TABLE "series_list" ALTER COLUMN "title_id" TYPE int USING "title_id"::int;
+
+ALTER TABLE "series_list" ALTER COLUMN "book_id" TYPE int USING "book_id"::int;
+
+ALTER TABLE "series_title" ALTER COLUMN "status_id" TYPE int USING "status_id"::int;
+
+ALTER TABLE "tag_list" ALTER COLUMN "book_id" TYPE int USING "book_id"::int;
+
+ALTER TABLE "tag_list" ALTER COLUMN "tag_id" TYPE int USING "tag_id"::int;
+
+ALTER TABLE "book" ALTER COLUMN "id" TYPE int USING "id"::int;
+
+ALTER TABLE "book_label" ALTER COLUMN "id" TYPE int USING "id"::int;
+
+ALTER TABLE "creator" ALTER COLUMN "id" TYPE int USING "id"::int;
+
+ALTER TABLE "publish" ALTER COLUMN "id" TYPE int USING "id"::int;
+
+ALTER TABLE "book_size" ALTER COLUMN "id" TYPE int USING "id"::int;
+
+ALTER TABLE "series_status" ALTER COLUMN "id" TYPE int USING "id"::int;
+
+ALTER TABLE "series_title" ALTER COLUMN "id" TYPE int USING "id"::int;
+
+ALTER TABLE "tag" ALTER COLUMN "id" TYPE int USING "id"::int;
+
+ALTER TABLE "book" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY;
+
+ALTER TABLE "book_label" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY;
+
+ALTER TABLE "creator" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY;
+
+ALTER TABLE "publish" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY;
+
+ALTER TABLE "book_size" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY;
+
+ALTER TABLE "series_status" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY;
+
+ALTER TABLE "series_title" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY;
+
+ALTER TABLE "tag" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY;
+
+ALTER TABLE "book" ADD FOREIGN KEY ("label_id") REFERENCES "book_label" ("id");
+
+ALTER TABLE "book" ADD FOREIGN KEY ("publish_id") REFERENCES "publish" ("id");
+
+ALTER TABLE "book" ADD FOREIGN KEY ("size_id") REFERENCES "book_size" ("id");
+
+ALTER TABLE "author_list" ADD FOREIGN KEY ("book_id") REFERENCES "book" ("id");
+
+ALTER TABLE "author_list" ADD FOREIGN KEY ("creator_id") REFERENCES "creator" ("id");
+
+ALTER TABLE "series_list" ADD FOREIGN KEY ("title_id") REFERENCES "series_title" ("id");
+
+ALTER TABLE "series_list" ADD FOREIGN KEY ("book_id") REFERENCES "book" ("id");
+
+ALTER TABLE "series_title" ADD FOREIGN KEY ("status_id") REFERENCES "series_status" ("id");
+
+ALTER TABLE "tag_list" ADD FOREIGN KEY ("book_id") REFERENCES "book" ("id");
+
+ALTER TABLE "tag_list" ADD FOREIGN KEY ("tag_id") REFERENCES "tag" ("id");
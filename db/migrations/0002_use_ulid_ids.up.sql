@@ -0,0 +1,97 @@
+-- ドメインのIDはULIDなので、エンティティの主キーと外部キーをULIDを格納できる型に変更する
+-- 既存の連番IDは0埋めして26文字にする(数字のみの26文字は有効なULIDである)
+
+ALTER TABLE "book" DROP CONSTRAINT "book_label_id_fkey";
+
+ALTER TABLE "book" DROP CONSTRAINT "book_publish_id_fkey";
+
+ALTER TABLE "book" DROP CONSTRAINT "book_size_id_fkey";
+
+ALTER TABLE "author_list" DROP CONSTRAINT "author_list_book_id_fkey";
+
+ALTER TABLE "author_list" DROP CONSTRAINT "author_list_creator_id_fkey";
+
+ALTER TABLE "series_list" DROP CONSTRAINT "series_list_title_id_fkey";
+
+ALTER TABLE "series_list" DROP CONSTRAINT "series_list_book_id_fkey";
+
+ALTER TABLE "series_title" DROP CONSTRAINT "series_title_status_id_fkey";
+
+ALTER TABLE "tag_list" DROP CONSTRAINT "tag_list_book_id_fkey";
+
+ALTER TABLE "tag_list" DROP CONSTRAINT "tag_list_tag_id_fkey";
+
+ALTER TABLE "book" ALTER COLUMN "id" DROP IDENTITY;
+
+ALTER TABLE "book_label" ALTER COLUMN "id" DROP IDENTITY;
+
+ALTER TABLE "creator" ALTER COLUMN "id" DROP IDENTITY;
+
+ALTER TABLE "publish" ALTER COLUMN "id" DROP IDENTITY;
+
+ALTER TABLE "book_size" ALTER COLUMN "id" DROP IDENTITY;
+
+ALTER TABLE "series_status" ALTER COLUMN "id" DROP IDENTITY;
+
+ALTER TABLE "series_title" ALTER COLUMN "id" DROP IDENTITY;
+
+ALTER TABLE "tag" ALTER COLUMN "id" DROP IDENTITY;
+
+ALTER TABLE "book" ALTER COLUMN "id" TYPE char(26) USING lpad("id"::text, 26, '0');
+
+ALTER TABLE "book_label" ALTER COLUMN "id" TYPE char(26) USING lpad("id"::text, 26, '0');
+
+ALTER TABLE "creator" ALTER COLUMN "id" TYPE char(26) USING lpad("id"::text, 26, '0');
+
+ALTER TABLE "publish" ALTER COLUMN "id" TYPE char(26) USING lpad("id"::text, 26, '0');
+
+ALTER TABLE "book_size" ALTER COLUMN "id" TYPE char(26) USING lpad("id"::text, 26, '0');
+
+ALTER TABLE "series_status" ALTER COLUMN "id" TYPE char(26) USING lpad("id"::text, 26, '0');
+
+ALTER TABLE "series_title" ALTER COLUMN "id" TYPE char(26) USING lpad("id"::text, 26, '0');
+
+ALTER TABLE "tag" ALTER COLUMN "id" TYPE char(26) USING lpad("id"::text, 26, '0');
+
+ALTER TABLE "book" ALTER COLUMN "label_id" TYPE char(26) USING lpad("label_id"::text, 26, '0');
+
+ALTER TABLE "book" ALTER COLUMN "publish_id" TYPE char(26) USING lpad("publish_id"::text, 26, '0');
+
+ALTER TABLE "book" ALTER COLUMN "size_id" TYPE char(26) USING lpad("size_id"::text, 26, '0');
+
+ALTER TABLE "author_list" ALTER COLUMN "book_id" TYPE char(26) USING lpad("book_id"::text, 26, '0');
+
+ALTER TABLE "author_list" ALTER COLUMN "creator_id" TYPE char(26) USING lpad("creator_id"::text, 26, '0');
+
+ALTER TABLE "series_list" ALTER COLUMN "title_id" TYPE char(26) USING lpad("title_id"::text, 26, '0');
+
+ALTER TABLE "series_list" ALTER COLUMN "book_id" TYPE char(26) USING lpad("book_id"::text, 26, '0');
+
+ALTER TABLE "series_title" ALTER COLUMN "status_id" TYPE char(26) USING lpad("status_id"::text, 26, '0');
+
+ALTER TABLE "tag_list" ALTER COLUMN "book_id" TYPE char(26) USING lpad("book_id"::text, 26, '0');
+
+ALTER TABLE "tag_list" ALTER COLUMN "tag_id" TYPE char(26) USING lpad("tag_id"::text, 26, '0');
+
+-- ISBN-13はINTに収まらないため文字列で保持する
+ALTER TABLE "book" ALTER COLUMN "book_isbn" TYPE varchar(13) USING "book_isbn"::text;
+
+ALTER TABLE "book" ADD FOREIGN KEY ("label_id") REFERENCES "book_label" ("id");
+
+ALTER TABLE "book" ADD FOREIGN KEY ("publish_id") REFERENCES "publish" ("id");
+
+ALTER TABLE "book" ADD FOREIGN KEY ("size_id") REFERENCES "book_size" ("id");
+
+ALTER TABLE "author_list" ADD FOREIGN KEY ("book_id") REFERENCES "book" ("id");
+
+ALTER TABLE "author_list" ADD FOREIGN KEY ("creator_id") REFERENCES "creator" ("id");
+
+ALTER TABLE "series_list" ADD FOREIGN KEY ("title_id") REFERENCES "series_title" ("id");
+
+ALTER TABLE "series_list" ADD FOREIGN KEY ("book_id") REFERENCES "book" ("id");
+
+ALTER TABLE "series_title" ADD FOREIGN KEY ("status_id") REFERENCES "series_status" ("id");
+
+ALTER TABLE "tag_list" ADD FOREIGN KEY ("book_id") REFERENCES "book" ("id");
+
+ALTER TABLE "tag_list" ADD FOREIGN KEY ("tag_id") REFERENCES "tag" ("id");
@@ -0,0 +1,3 @@
+ALTER TABLE "tag_list" DROP CONSTRAINT "tag_list_book_id_tag_id_key";
+
+ALTER TABLE "tag" DROP COLUMN "tag_delete_time";
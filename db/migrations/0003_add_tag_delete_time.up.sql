@@ -0,0 +1,3 @@
+ALTER TABLE "tag" ADD COLUMN "tag_delete_time" timestamp;
+
+ALTER TABLE "tag_list" ADD CONSTRAINT "tag_list_book_id_tag_id_key" UNIQUE ("book_id", "tag_id");
@@ -0,0 +1,7 @@
+DELETE FROM "series_status"
+WHERE "id" IN (
+  '01M541MXJ7FEG74PAT9RT4M4XF',
+  '01M541MXJ7FEG74PAT9W2XCQGN',
+  '01M541MXJ7FEG74PAT9ZFVS2BP',
+  '01M541MXJ7FEG74PATA1MV9AWF'
+);
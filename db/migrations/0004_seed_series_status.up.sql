@@ -0,0 +1,7 @@
+-- シリーズのステータスはドメイン(seriesstatus)で定義した値のみを扱う
+INSERT INTO "series_status" ("id", "status", "status_add_time", "status_update_time") VALUES
+  ('01M541MXJ7FEG74PAT9RT4M4XF', '連載中', now(), now()),
+  ('01M541MXJ7FEG74PAT9W2XCQGN', '完結', now(), now()),
+  ('01M541MXJ7FEG74PAT9ZFVS2BP', '休載', now(), now()),
+  ('01M541MXJ7FEG74PATA1MV9AWF', '打ち切り', now(), now())
+ON CONFLICT ("status") DO NOTHING;
@@ -0,0 +1,4 @@
+-- 整数でない巻数は戻せないため、このマイグレーション以前のデータのみ戻すことができる
+ALTER TABLE "series_list" DROP CONSTRAINT "series_list_title_id_part_number_key";
+
+ALTER TABLE "series_list" ALTER COLUMN "part_number" TYPE int USING "part_number"::int;
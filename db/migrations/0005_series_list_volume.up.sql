@@ -0,0 +1,4 @@
+-- 巻数は 10.5 や 上/下、外伝 などを扱えるよう表記のまま保持する
+ALTER TABLE "series_list" ALTER COLUMN "part_number" TYPE varchar USING "part_number"::text;
+
+ALTER TABLE "series_list" ADD CONSTRAINT "series_list_title_id_part_number_key" UNIQUE ("title_id", "part_number");
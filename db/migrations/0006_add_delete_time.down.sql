@@ -0,0 +1,11 @@
+ALTER TABLE "series_title" DROP COLUMN "series_title_delete_time";
+
+ALTER TABLE "book_size" DROP COLUMN "size_delete_time";
+
+ALTER TABLE "publish" DROP COLUMN "publish_delete_time";
+
+ALTER TABLE "creator" DROP COLUMN "creator_delete_time";
+
+ALTER TABLE "book_label" DROP COLUMN "label_delete_time";
+
+ALTER TABLE "book" DROP COLUMN "book_delete_time";
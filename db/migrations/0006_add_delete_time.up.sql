@@ -0,0 +1,11 @@
+ALTER TABLE "book" ADD COLUMN "book_delete_time" timestamp;
+
+ALTER TABLE "book_label" ADD COLUMN "label_delete_time" timestamp;
+
+ALTER TABLE "creator" ADD COLUMN "creator_delete_time" timestamp;
+
+ALTER TABLE "publish" ADD COLUMN "publish_delete_time" timestamp;
+
+ALTER TABLE "book_size" ADD COLUMN "size_delete_time" timestamp;
+
+ALTER TABLE "series_title" ADD COLUMN "series_title_delete_time" timestamp;
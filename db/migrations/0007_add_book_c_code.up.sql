@@ -0,0 +1 @@
+ALTER TABLE "book" ADD COLUMN "book_c_code" char(4);
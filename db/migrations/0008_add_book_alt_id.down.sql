@@ -0,0 +1,4 @@
+ALTER TABLE "book" DROP CONSTRAINT "book_isbn_or_alt_id_check";
+
+ALTER TABLE "book" DROP COLUMN "book_circle_name";
+ALTER TABLE "book" DROP COLUMN "book_event_name";
@@ -0,0 +1,5 @@
+-- ISBNのない同人誌は頒布イベントとサークルで識別する
+ALTER TABLE "book" ADD COLUMN "book_event_name" varchar;
+ALTER TABLE "book" ADD COLUMN "book_circle_name" varchar;
+
+ALTER TABLE "book" ADD CONSTRAINT "book_isbn_or_alt_id_check" CHECK ("book_isbn" IS NULL OR "book_event_name" IS NULL);
@@ -0,0 +1 @@
+ALTER TABLE "book" DROP COLUMN "book_title_phonic";
@@ -0,0 +1,2 @@
+-- タイトルの読み。五十音順に並べるために使う
+ALTER TABLE "book" ADD COLUMN "book_title_phonic" varchar;
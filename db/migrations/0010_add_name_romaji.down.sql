@@ -0,0 +1,3 @@
+ALTER TABLE "publish" DROP COLUMN "publish_name_romaji";
+ALTER TABLE "book_label" DROP COLUMN "label_romaji";
+ALTER TABLE "creator" DROP COLUMN "creator_name_romaji";
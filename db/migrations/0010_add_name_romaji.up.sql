@@ -0,0 +1,5 @@
+-- 読みをヘボン式のローマ字にしたもの。IMEのない環境での検索に使う
+-- 既存の行は go run ./cmd/romaji で埋める
+ALTER TABLE "creator" ADD COLUMN "creator_name_romaji" varchar;
+ALTER TABLE "book_label" ADD COLUMN "label_romaji" varchar;
+ALTER TABLE "publish" ADD COLUMN "publish_name_romaji" varchar;
@@ -0,0 +1,3 @@
+DROP INDEX "author_list_creator_id_role_idx";
+ALTER TABLE "author_list" DROP COLUMN "author_list_order";
+ALTER TABLE "author_list" DROP COLUMN "author_list_role";
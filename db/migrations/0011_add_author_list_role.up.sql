@@ -0,0 +1,9 @@
+-- 奥付の著者の役割と並び順。既存のクレジットは「作」とし、登録順を並び順にする
+ALTER TABLE "author_list" ADD COLUMN "author_list_role" varchar NOT NULL DEFAULT '作';
+ALTER TABLE "author_list" ADD COLUMN "author_list_order" int;
+UPDATE "author_list" SET "author_list_order" = "numbered"."n"
+FROM (SELECT "id", row_number() OVER (PARTITION BY "book_id" ORDER BY "id") - 1 AS "n" FROM "author_list") AS "numbered"
+WHERE "author_list"."id" = "numbered"."id";
+ALTER TABLE "author_list" ALTER COLUMN "author_list_order" SET NOT NULL;
+-- 著者と役割から書籍を探すため
+CREATE INDEX "author_list_creator_id_role_idx" ON "author_list" ("creator_id", "author_list_role");
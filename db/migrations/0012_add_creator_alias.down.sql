@@ -0,0 +1,3 @@
+DROP TABLE "creator_merge";
+DROP INDEX "creator_canonical_id_idx";
+ALTER TABLE "creator" DROP COLUMN "creator_canonical_id";
@@ -0,0 +1,12 @@
+-- ペンネームや表記揺れの著者が指す本人の著者
+ALTER TABLE "creator" ADD COLUMN "creator_canonical_id" char(26) REFERENCES "creator" ("id");
+CREATE INDEX "creator_canonical_id_idx" ON "creator" ("creator_canonical_id");
+
+-- 重複した著者を統合した履歴
+-- 統合元の著者を物理削除しても残すため外部キーは付けない
+CREATE TABLE "creator_merge" (
+  "id" char(26) PRIMARY KEY,
+  "duplicate_creator_id" char(26) NOT NULL,
+  "canonical_creator_id" char(26) NOT NULL,
+  "creator_merge_add_time" timestamp NOT NULL
+);
@@ -0,0 +1 @@
+DROP TABLE "duplicate_candidate";
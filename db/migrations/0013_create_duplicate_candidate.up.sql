@@ -0,0 +1,16 @@
+-- 重複の候補
+-- 統合済みや候補から外した組を再び候補にしないため、対応後の行も残す
+-- 対象は書籍、著者、レーベル、出版社のいずれかなので外部キーは付けない
+CREATE TABLE "duplicate_candidate" (
+  "id" char(26) PRIMARY KEY,
+  "candidate_kind" varchar NOT NULL,
+  "duplicate_id" char(26) NOT NULL,
+  "canonical_id" char(26) NOT NULL,
+  "candidate_reasons" varchar NOT NULL,
+  "candidate_score" int NOT NULL,
+  "candidate_status" varchar NOT NULL DEFAULT 'pending',
+  "candidate_detect_time" timestamp NOT NULL,
+  "candidate_resolve_time" timestamp,
+  UNIQUE ("candidate_kind", "duplicate_id", "canonical_id")
+);
+CREATE INDEX "duplicate_candidate_status_idx" ON "duplicate_candidate" ("candidate_status", "candidate_score");
@@ -0,0 +1,3 @@
+DROP INDEX "book_alt_id_key";
+ALTER TABLE "book" DROP CONSTRAINT "book_alt_id_check";
+ALTER TABLE "book" DROP COLUMN "book_item_code";
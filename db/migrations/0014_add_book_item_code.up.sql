@@ -0,0 +1,11 @@
+-- 同じイベントで同じサークルが複数の頒布物を出すため、サークルが付けた品番で区別する
+ALTER TABLE "book" ADD COLUMN "book_item_code" varchar;
+
+-- 品番のない既存の同人誌は書籍IDを仮の品番にする
+UPDATE "book" SET "book_item_code" = "id" WHERE "book_event_name" IS NOT NULL;
+
+ALTER TABLE "book" ADD CONSTRAINT "book_alt_id_check" CHECK (
+  ("book_event_name" IS NULL AND "book_circle_name" IS NULL AND "book_item_code" IS NULL)
+  OR ("book_event_name" IS NOT NULL AND "book_circle_name" IS NOT NULL AND "book_item_code" IS NOT NULL)
+);
+CREATE UNIQUE INDEX "book_alt_id_key" ON "book" ("book_event_name", "book_circle_name", "book_item_code");
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+)
+
+func TestNegotiateLang(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           errDomain.Lang
+	}{
+		{name: "正常系: 指定なし", acceptLanguage: "", want: errDomain.LangJa},
+		{name: "正常系: 英語", acceptLanguage: "en", want: errDomain.LangEn},
+		{name: "正常系: 地域付き", acceptLanguage: "en-US", want: errDomain.LangEn},
+		{name: "正常系: 優先度", acceptLanguage: "ja;q=0.5, en-GB;q=0.8", want: errDomain.LangEn},
+		{name: "正常系: 先頭を優先", acceptLanguage: "ja-JP,en;q=1", want: errDomain.LangJa},
+		{name: "正常系: 未対応の言語を飛ばす", acceptLanguage: "fr-FR,fr;q=0.9,en;q=0.8", want: errDomain.LangEn},
+		{name: "正常系: 未対応の言語のみ", acceptLanguage: "fr", want: errDomain.LangJa},
+		{name: "正常系: q=0は除外", acceptLanguage: "en;q=0", want: errDomain.LangJa},
+		{name: "正常系: 不正なqは無視", acceptLanguage: "en;q=x", want: errDomain.LangJa},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NegotiateLang(tt.acceptLanguage); got != tt.want {
+				t.Errorf("NegotiateLang() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	var verr errDomain.ValidationError
+	verr.Add(errDomain.NewMessageError(errDomain.CodeInvalid, "isbn", "book.isbn.invalid"))
+	verr.Add(errDomain.NewMessageError(errDomain.CodeTooShort, "title", "book.title.too_short", 1))
+
+	tests := []struct {
+		name           string
+		err            error
+		acceptLanguage string
+		wantStatus     int
+		want           errorResponse
+	}{
+		{
+			name:           "正常系: バリデーションエラーを英語で返す",
+			err:            verr.Err(),
+			acceptLanguage: "en-US,en;q=0.9",
+			wantStatus:     http.StatusBadRequest,
+			want: errorResponse{Errors: []errorDetail{
+				{Code: errDomain.CodeInvalid, Field: "isbn", Message: "Invalid ISBN"},
+				{Code: errDomain.CodeTooShort, Field: "title", Message: "Title must be at least 1 characters"},
+			}},
+		},
+		{
+			name:           "正常系: 日本語",
+			err:            errDomain.ErrNotFound,
+			acceptLanguage: "ja",
+			wantStatus:     http.StatusNotFound,
+			want: errorResponse{Errors: []errorDetail{
+				{Code: errDomain.CodeNotFound, Message: "対象が見つかりません"},
+			}},
+		},
+		{
+			name:       "正常系: ドメイン外のエラー",
+			err:        errors.New("connection refused"),
+			wantStatus: http.StatusInternalServerError,
+			want: errorResponse{Errors: []errorDetail{
+				{Code: errDomain.CodeUnknown, Message: "Internal Server Error"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept-Language", tt.acceptLanguage)
+			w := httptest.NewRecorder()
+			WriteError(w, r, tt.err)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %v, want = %v", w.Code, tt.wantStatus)
+			}
+			var got errorResponse
+			if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("WriteError() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/duplicate"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+)
+
+type candidatesResponse struct {
+	Candidates []candidateItem `json:"candidates"`
+}
+
+type candidateItem struct {
+	ID          string             `json:"id"`
+	Kind        duplicate.Kind     `json:"kind"`
+	DuplicateID string             `json:"duplicateId"`
+	CanonicalID string             `json:"canonicalId"`
+	Score       int                `json:"score"`
+	Reasons     []duplicate.Reason `json:"reasons"`
+	Status      duplicate.Status   `json:"status"`
+	DetectedAt  time.Time          `json:"detectedAt"`
+	ResolvedAt  *time.Time         `json:"resolvedAt,omitempty"`
+}
+
+func newCandidateItem(c *duplicate.Candidate) candidateItem {
+	return candidateItem{
+		ID:          c.ID(),
+		Kind:        c.Kind(),
+		DuplicateID: c.DuplicateID(),
+		CanonicalID: c.CanonicalID(),
+		Score:       c.Score(),
+		Reasons:     c.Reasons(),
+		Status:      c.Status(),
+		DetectedAt:  c.DetectedAt(),
+		ResolvedAt:  c.ResolvedAt(),
+	}
+}
+
+// duplicatesHandler は未対応の重複候補を点数の高い順に返す
+// クエリの kind で種類を、minScore で点数の下限を指定できる
+func duplicatesHandler(repo duplicate.CandidateRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		kind := duplicate.Kind(r.URL.Query().Get("kind"))
+		if kind != "" && !kind.IsValid() {
+			WriteError(w, r, errDomain.NewMessageError(errDomain.CodeInvalid, "kind", "duplicate.kind.invalid"))
+			return
+		}
+		minScore := 0
+		if q := r.URL.Query().Get("minScore"); q != "" {
+			var err error
+			if minScore, err = strconv.Atoi(q); err != nil || minScore < 0 || minScore > 100 {
+				WriteError(w, r, errDomain.NewMessageError(errDomain.CodeInvalid, "minScore", "duplicate.min_score.invalid"))
+				return
+			}
+		}
+
+		candidates, err := repo.FindPending(r.Context())
+		if err != nil {
+			WriteError(w, r, err)
+			return
+		}
+		candidates = slices.DeleteFunc(candidates, func(c *duplicate.Candidate) bool {
+			return (kind != "" && c.Kind() != kind) || c.Score() < minScore
+		})
+
+		res := candidatesResponse{Candidates: []candidateItem{}}
+		for _, c := range candidates {
+			res.Candidates = append(res.Candidates, newCandidateItem(c))
+		}
+		writeJSON(w, http.StatusOK, res)
+	}
+}
+
+// mergeDuplicateHandler は候補の duplicate を canonical に統合し、統合済みの候補を返す
+func mergeDuplicateHandler(repo duplicate.CandidateRepository, merger duplicate.Merger, clk clock.Clock) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c, err := repo.FindByID(r.Context(), r.PathValue("id"))
+		if err != nil {
+			WriteError(w, r, err)
+			return
+		}
+		if err := merger.Merge(r.Context(), clk, c); err != nil {
+			WriteError(w, r, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, newCandidateItem(c))
+	}
+}
+
+// dismissDuplicateHandler は重複ではないとして候補から外し、外した候補を返す
+func dismissDuplicateHandler(repo duplicate.CandidateRepository, clk clock.Clock) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c, err := repo.FindByID(r.Context(), r.PathValue("id"))
+		if err != nil {
+			WriteError(w, r, err)
+			return
+		}
+		if err := c.Dismiss(clk); err != nil {
+			WriteError(w, r, err)
+			return
+		}
+		if err := repo.Save(r.Context(), c); err != nil {
+			WriteError(w, r, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, newCandidateItem(c))
+	}
+}
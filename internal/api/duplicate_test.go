@@ -0,0 +1,192 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/duplicate"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+type fakeCandidateRepository struct {
+	duplicate.CandidateRepository
+	candidates []*duplicate.Candidate
+}
+
+func (r *fakeCandidateRepository) FindByID(ctx context.Context, id string) (*duplicate.Candidate, error) {
+	for _, c := range r.candidates {
+		if c.ID() == id {
+			return c, nil
+		}
+	}
+	return nil, errDomain.ErrNotFound
+}
+
+func (r *fakeCandidateRepository) FindPending(ctx context.Context) ([]*duplicate.Candidate, error) {
+	return slices.DeleteFunc(slices.Clone(r.candidates), func(c *duplicate.Candidate) bool {
+		return c.Status() != duplicate.StatusPending
+	}), nil
+}
+
+func (r *fakeCandidateRepository) Save(ctx context.Context, c *duplicate.Candidate) error {
+	return nil
+}
+
+type fakeMerger struct {
+	merged []string
+}
+
+func (m *fakeMerger) Merge(ctx context.Context, clk clock.Clock, c *duplicate.Candidate) error {
+	if err := c.MarkMerged(clk); err != nil {
+		return err
+	}
+	m.merged = append(m.merged, c.DuplicateID())
+	return nil
+}
+
+func newCandidates(t *testing.T, clk clock.Clock) []*duplicate.Candidate {
+	t.Helper()
+	var candidates []*duplicate.Candidate
+	for _, v := range []struct {
+		kind    duplicate.Kind
+		reasons []duplicate.Reason
+	}{
+		{duplicate.KindBook, []duplicate.Reason{duplicate.ReasonSameTitle}},
+		{duplicate.KindAuthor, []duplicate.Reason{duplicate.ReasonSameName}},
+		{duplicate.KindLabel, []duplicate.Reason{duplicate.ReasonSameNamePhonic}},
+	} {
+		c, err := duplicate.NewCandidate(clk, v.kind, ulid.NewULID(), ulid.NewULID(), v.reasons)
+		if err != nil {
+			t.Fatal(err)
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates
+}
+
+func TestDuplicatesHandler(t *testing.T) {
+	clk := clock.NewFixed(time.Now())
+	router := NewRouter(nil, nil, nil, nil, &fakeCandidateRepository{candidates: newCandidates(t, clk)}, nil, clk)
+	tests := []struct {
+		name       string
+		target     string
+		wantStatus int
+		wantKinds  []duplicate.Kind
+	}{
+		{
+			name:       "正常系",
+			target:     "/duplicates",
+			wantStatus: http.StatusOK,
+			wantKinds:  []duplicate.Kind{duplicate.KindBook, duplicate.KindAuthor, duplicate.KindLabel},
+		},
+		{
+			name:       "正常系: 種類を指定",
+			target:     "/duplicates?kind=author",
+			wantStatus: http.StatusOK,
+			wantKinds:  []duplicate.Kind{duplicate.KindAuthor},
+		},
+		{
+			name:       "正常系: 点数の下限を指定",
+			target:     "/duplicates?minScore=50",
+			wantStatus: http.StatusOK,
+			wantKinds:  []duplicate.Kind{duplicate.KindBook, duplicate.KindAuthor},
+		},
+		{
+			name:       "異常系: 種類が不正",
+			target:     "/duplicates?kind=series",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "異常系: 点数の下限が不正",
+			target:     "/duplicates?minScore=101",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %v, want = %v", w.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var got candidatesResponse
+			if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			kinds := []duplicate.Kind{}
+			for _, c := range got.Candidates {
+				kinds = append(kinds, c.Kind)
+			}
+			if diff := cmp.Diff(kinds, tt.wantKinds); diff != "" {
+				t.Errorf("GET %s = %v, want = %v.\n error is %s", tt.target, kinds, tt.wantKinds, diff)
+			}
+		})
+	}
+}
+
+func TestMergeDuplicateHandler(t *testing.T) {
+	clk := clock.NewFixed(time.Now())
+	candidates := newCandidates(t, clk)
+	merger := &fakeMerger{}
+	router := NewRouter(nil, nil, nil, nil, &fakeCandidateRepository{candidates: candidates}, merger, clk)
+	tests := []struct {
+		name       string
+		target     string
+		wantStatus int
+	}{
+		{
+			name:       "正常系: 統合",
+			target:     "/duplicates/" + candidates[0].ID() + "/merge",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "正常系: 候補から外す",
+			target:     "/duplicates/" + candidates[1].ID() + "/dismiss",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "異常系: 対応済み",
+			target:     "/duplicates/" + candidates[0].ID() + "/dismiss",
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "異常系: 存在しない",
+			target:     "/duplicates/" + ulid.NewULID() + "/merge",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, tt.target, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %v, want = %v", w.Code, tt.wantStatus)
+			}
+		})
+	}
+
+	want := []duplicate.Status{duplicate.StatusMerged, duplicate.StatusDismissed, duplicate.StatusPending}
+	got := []duplicate.Status{}
+	for _, c := range candidates {
+		got = append(got, c.Status())
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Status() = %v, want = %v.\n error is %s", got, want, diff)
+	}
+	if diff := cmp.Diff(merger.merged, []string{candidates[0].DuplicateID()}); diff != "" {
+		t.Errorf("merged = %v.\n error is %s", merger.merged, diff)
+	}
+}
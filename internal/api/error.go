@@ -0,0 +1,66 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+)
+
+type errorResponse struct {
+	Errors []errorDetail `json:"errors"`
+}
+
+type errorDetail struct {
+	Code    errDomain.Code `json:"code"`
+	Field   string         `json:"field,omitempty"`
+	Message string         `json:"message"`
+}
+
+// WriteError はエラーをJSONで返す
+// メッセージは Accept-Language で選んだ言語にし、バリデーションエラーは項目ごとに返す
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	lang := requestLang(r)
+	var details []errorDetail
+	var verr *errDomain.ValidationError
+	var derr *errDomain.Error
+	switch {
+	case errors.As(err, &verr):
+		for _, e := range verr.Errors() {
+			details = append(details, newErrorDetail(e, lang))
+		}
+	case errors.As(err, &derr):
+		details = append(details, newErrorDetail(derr, lang))
+	default:
+		// ドメイン外のエラーは内部の情報を含みうるため詳細を返さない
+		details = append(details, errorDetail{
+			Code:    errDomain.CodeUnknown,
+			Message: http.StatusText(http.StatusInternalServerError),
+		})
+	}
+
+	w.Header().Set("Content-Language", string(lang))
+	writeJSON(w, statusCode(details[0].Code), errorResponse{Errors: details})
+}
+
+func newErrorDetail(err *errDomain.Error, lang errDomain.Lang) errorDetail {
+	return errorDetail{
+		Code:    err.Code(),
+		Field:   err.Field(),
+		Message: err.Localize(lang),
+	}
+}
+
+// statusCode はエラーコードに対応するHTTPステータスを返す
+func statusCode(code errDomain.Code) int {
+	switch code {
+	case errDomain.CodeInvalid, errDomain.CodeTooShort, errDomain.CodeOutOfRange:
+		return http.StatusBadRequest
+	case errDomain.CodeNotFound:
+		return http.StatusNotFound
+	case errDomain.CodeDuplicate, errDomain.CodeConflict:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
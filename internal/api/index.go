@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/pkg/gojuon"
+)
+
+// phonicNamed は名前と読みを持つエンティティ
+type phonicNamed interface {
+	ID() string
+	Name() string
+	NamePhonic() string
+	NameRomaji() string
+	Slug() string
+}
+
+// readingLister は読みの五十音順で一覧を返すリポジトリ
+type readingLister[T phonicNamed] interface {
+	FindAllOrderByReading(ctx context.Context, opts ...repository.FindOption) ([]T, error)
+	FindByIndexRow(ctx context.Context, row gojuon.Row, opts ...repository.FindOption) ([]T, error)
+	FindByRomaji(ctx context.Context, q string, opts ...repository.FindOption) ([]T, error)
+	FindBySlug(ctx context.Context, slug string, opts ...repository.FindOption) ([]T, error)
+}
+
+type indexResponse struct {
+	Sections []indexSection `json:"sections"`
+}
+
+type indexSection struct {
+	Row   gojuon.Row  `json:"row"`
+	Items []indexItem `json:"items"`
+}
+
+type indexItem struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	NamePhonic string `json:"namePhonic"`
+	NameRomaji string `json:"nameRomaji"`
+	Slug       string `json:"slug"`
+}
+
+func newIndexItem[T phonicNamed](item T) indexItem {
+	return indexItem{
+		ID:         item.ID(),
+		Name:       item.Name(),
+		NamePhonic: item.NamePhonic(),
+		NameRomaji: item.NameRomaji(),
+		Slug:       item.Slug(),
+	}
+}
+
+type slugResponse struct {
+	Items []indexItem `json:"items"`
+}
+
+// indexHandler は五十音の索引の行ごとにまとめた一覧を返す
+// クエリの row で "か" のように行を指定するとその行だけを返す
+// クエリの q を指定するとローマ字の読みで検索する
+func indexHandler[T phonicNamed](repo readingLister[T]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var row gojuon.Row
+		if q := r.URL.Query().Get("row"); q != "" {
+			var ok bool
+			if row, ok = gojuon.ParseRow(q); !ok {
+				WriteError(w, r, errDomain.NewMessageError(errDomain.CodeInvalid, "row", "index.row.invalid"))
+				return
+			}
+		}
+
+		var items []T
+		var err error
+		switch q := r.URL.Query().Get("q"); {
+		case q != "":
+			items, err = repo.FindByRomaji(r.Context(), q)
+			if row != "" {
+				items = slices.DeleteFunc(items, func(item T) bool {
+					return gojuon.RowOf(item.NamePhonic()) != row
+				})
+			}
+		case row != "":
+			items, err = repo.FindByIndexRow(r.Context(), row)
+		default:
+			items, err = repo.FindAllOrderByReading(r.Context())
+		}
+		if err != nil {
+			WriteError(w, r, err)
+			return
+		}
+
+		res := indexResponse{Sections: []indexSection{}}
+		for _, section := range gojuon.Group(items, T.NamePhonic) {
+			s := indexSection{Row: section.Row}
+			for _, item := range section.Items {
+				s.Items = append(s.Items, newIndexItem(item))
+			}
+			res.Sections = append(res.Sections, s)
+		}
+		writeJSON(w, http.StatusOK, res)
+	}
+}
+
+// slugHandler はURLのローマ字の読みと一致するものを返す
+// 同じ読みのものが複数あればすべて返す
+func slugHandler[T phonicNamed](repo readingLister[T]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		items, err := repo.FindBySlug(r.Context(), r.PathValue("slug"))
+		if err != nil {
+			WriteError(w, r, err)
+			return
+		}
+		if len(items) == 0 {
+			WriteError(w, r, errDomain.ErrNotFound)
+			return
+		}
+
+		res := slugResponse{Items: []indexItem{}}
+		for _, item := range items {
+			res.Items = append(res.Items, newIndexItem(item))
+		}
+		writeJSON(w, http.StatusOK, res)
+	}
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/author"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/gojuon"
+	"github.com/mitsu-yuki/shisho-backend/pkg/romaji"
+)
+
+type fakeAuthorRepository struct {
+	author.AuthorRepository
+	authors []*author.Author
+}
+
+func (r *fakeAuthorRepository) FindAllOrderByReading(ctx context.Context, opts ...repository.FindOption) ([]*author.Author, error) {
+	authors := slices.Clone(r.authors)
+	gojuon.Sort(authors, (*author.Author).NamePhonic)
+	return authors, nil
+}
+
+func (r *fakeAuthorRepository) FindByIndexRow(ctx context.Context, row gojuon.Row, opts ...repository.FindOption) ([]*author.Author, error) {
+	authors, _ := r.FindAllOrderByReading(ctx)
+	return slices.DeleteFunc(authors, func(a *author.Author) bool {
+		return gojuon.RowOf(a.NamePhonic()) != row
+	}), nil
+}
+
+func (r *fakeAuthorRepository) FindByRomaji(ctx context.Context, q string, opts ...repository.FindOption) ([]*author.Author, error) {
+	authors, _ := r.FindAllOrderByReading(ctx)
+	return slices.DeleteFunc(authors, func(a *author.Author) bool {
+		return !strings.Contains(strings.ReplaceAll(a.NameRomaji(), " ", ""), romaji.NormalizeQuery(q))
+	}), nil
+}
+
+func (r *fakeAuthorRepository) FindBySlug(ctx context.Context, slug string, opts ...repository.FindOption) ([]*author.Author, error) {
+	authors := slices.Clone(r.authors)
+	return slices.DeleteFunc(authors, func(a *author.Author) bool {
+		return a.Slug() != slug
+	}), nil
+}
+
+func newAuthors(t *testing.T) []*author.Author {
+	t.Helper()
+	var authors []*author.Author
+	for _, name := range [][2]string{{"夏目漱石", "なつめ そうせき"}, {"芥川龍之介", "アクタガワ リュウノスケ"}, {"太宰治", "ダザイ オサム"}, {"川端康成", "カワバタ ヤスナリ"}} {
+		a, err := author.NewAuthor(clock.NewFixed(time.Now()), name[0], name[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		authors = append(authors, a)
+	}
+	return authors
+}
+
+func TestIndexHandler(t *testing.T) {
+	authors := newAuthors(t)
+	router := NewRouter(&fakeAuthorRepository{authors: authors}, nil, nil, nil, nil, nil, nil)
+
+	tests := []struct {
+		name       string
+		target     string
+		wantStatus int
+		want       indexResponse
+	}{
+		{
+			name:       "正常系",
+			target:     "/authors",
+			wantStatus: http.StatusOK,
+			want: indexResponse{Sections: []indexSection{
+				{Row: gojuon.RowA, Items: []indexItem{{Name: "芥川龍之介", NamePhonic: "アクタガワ　リュウノスケ", NameRomaji: "akutagawa ryunosuke", Slug: "akutagawa-ryunosuke"}}},
+				{Row: gojuon.RowKa, Items: []indexItem{{Name: "川端康成", NamePhonic: "カワバタ　ヤスナリ", NameRomaji: "kawabata yasunari", Slug: "kawabata-yasunari"}}},
+				{Row: gojuon.RowTa, Items: []indexItem{{Name: "太宰治", NamePhonic: "ダザイ　オサム", NameRomaji: "dazai osamu", Slug: "dazai-osamu"}}},
+				{Row: gojuon.RowNa, Items: []indexItem{{Name: "夏目漱石", NamePhonic: "ナツメ　ソウセキ", NameRomaji: "natsume soseki", Slug: "natsume-soseki"}}},
+			}},
+		},
+		{
+			name:       "正常系: 行を指定",
+			target:     "/authors?row=た",
+			wantStatus: http.StatusOK,
+			want: indexResponse{Sections: []indexSection{
+				{Row: gojuon.RowTa, Items: []indexItem{{Name: "太宰治", NamePhonic: "ダザイ　オサム", NameRomaji: "dazai osamu", Slug: "dazai-osamu"}}},
+			}},
+		},
+		{
+			name:       "正常系: ローマ字で検索",
+			target:     "/authors?q=Natsume-Souseki",
+			wantStatus: http.StatusOK,
+			want: indexResponse{Sections: []indexSection{
+				{Row: gojuon.RowNa, Items: []indexItem{{Name: "夏目漱石", NamePhonic: "ナツメ　ソウセキ", NameRomaji: "natsume soseki", Slug: "natsume-soseki"}}},
+			}},
+		},
+		{
+			name:       "正常系: ローマ字で検索して行を指定",
+			target:     "/authors?q=ta&row=あ",
+			wantStatus: http.StatusOK,
+			want: indexResponse{Sections: []indexSection{
+				{Row: gojuon.RowA, Items: []indexItem{{Name: "芥川龍之介", NamePhonic: "アクタガワ　リュウノスケ", NameRomaji: "akutagawa ryunosuke", Slug: "akutagawa-ryunosuke"}}},
+			}},
+		},
+		{
+			name:       "正常系: 該当なし",
+			target:     "/authors?row=ま",
+			wantStatus: http.StatusOK,
+			want:       indexResponse{Sections: []indexSection{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %v, want = %v", w.Code, tt.wantStatus)
+			}
+			var got indexResponse
+			if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, tt.want, cmpopts.IgnoreFields(indexItem{}, "ID")); diff != "" {
+				t.Errorf("GET %s = %v, want = %v.\n error is %s", tt.target, got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestIndexHandler_InvalidRow(t *testing.T) {
+	router := NewRouter(&fakeAuthorRepository{}, nil, nil, nil, nil, nil, nil)
+	r := httptest.NewRequest(http.MethodGet, "/authors?row=x", nil)
+	r.Header.Set("Accept-Language", "en")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %v, want = %v", w.Code, http.StatusBadRequest)
+	}
+	var got errorResponse
+	if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	want := errorResponse{Errors: []errorDetail{{Code: "invalid", Field: "row", Message: "Invalid index row"}}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("GET /authors?row=x = %v, want = %v.\n error is %s", got, want, diff)
+	}
+}
+
+func TestSlugHandler(t *testing.T) {
+	router := NewRouter(&fakeAuthorRepository{authors: newAuthors(t)}, nil, nil, nil, nil, nil, nil)
+	tests := []struct {
+		name       string
+		target     string
+		wantStatus int
+		want       slugResponse
+	}{
+		{
+			name:       "正常系",
+			target:     "/authors/dazai-osamu",
+			wantStatus: http.StatusOK,
+			want:       slugResponse{Items: []indexItem{{Name: "太宰治", NamePhonic: "ダザイ　オサム", NameRomaji: "dazai osamu", Slug: "dazai-osamu"}}},
+		},
+		{
+			name:       "異常系: 存在しない",
+			target:     "/authors/oda-eiichiro",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %v, want = %v", w.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var got slugResponse
+			if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, tt.want, cmpopts.IgnoreFields(indexItem{}, "ID")); diff != "" {
+				t.Errorf("GET %s = %v, want = %v.\n error is %s", tt.target, got, tt.want, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+)
+
+// NegotiateLang は Accept-Language から対応している言語のうち最も優先度の高いものを選ぶ
+// 対応している言語がなければ日本語を返す
+func NegotiateLang(acceptLanguage string) errDomain.Lang {
+	best, bestQ := errDomain.DefaultLang, 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		lang, ok := errDomain.ParseLang(tag)
+		if !ok || q <= bestQ {
+			continue
+		}
+		best, bestQ = lang, q
+	}
+	return best
+}
+
+// requestLang はリクエストの Accept-Language から言語を選ぶ
+func requestLang(r *http.Request) errDomain.Lang {
+	return NegotiateLang(r.Header.Get("Accept-Language"))
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"net/http"
+	"unicode/utf8"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/reading"
+)
+
+// readingQLengthMax は読みを推定する文字列の最大の文字数
+// 外部の形態素解析器に長い入力を渡さないように制限する
+const readingQLengthMax = 100
+
+type readingResponse struct {
+	Suggestions []string `json:"suggestions"`
+}
+
+// readingHandler は登録画面で読みを補うために、クエリの q の読みの候補を返す
+func readingHandler(suggester reading.Suggester) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query().Get("q")
+		if q == "" {
+			WriteError(w, r, errDomain.NewMessageError(errDomain.CodeTooShort, "q", "reading.q.required"))
+			return
+		}
+		if utf8.RuneCountInString(q) > readingQLengthMax {
+			WriteError(w, r, errDomain.NewMessageError(errDomain.CodeOutOfRange, "q", "reading.q.too_long", readingQLengthMax))
+			return
+		}
+		suggestions, err := suggester.Suggest(r.Context(), q)
+		if err != nil {
+			WriteError(w, r, err)
+			return
+		}
+		if suggestions == nil {
+			suggestions = []string{}
+		}
+		writeJSON(w, http.StatusOK, readingResponse{Suggestions: suggestions})
+	}
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/reading"
+)
+
+func TestReadingHandler(t *testing.T) {
+	router := NewRouter(nil, nil, nil, reading.NewDictionary(reading.WithEntries(map[string][]string{
+		"夏目": {"ナツメ"},
+		"漱石": {"ソウセキ"},
+	})), nil, nil, nil)
+	tests := []struct {
+		name       string
+		q          string
+		wantStatus int
+		want       any
+	}{
+		{
+			name:       "正常系",
+			q:          "夏目 漱石",
+			wantStatus: http.StatusOK,
+			want:       readingResponse{Suggestions: []string{"ナツメ　ソウセキ"}},
+		},
+		{
+			name:       "正常系: 候補なし",
+			q:          "芥川龍之介",
+			wantStatus: http.StatusOK,
+			want:       readingResponse{Suggestions: []string{}},
+		},
+		{
+			name:       "異常系: qが長すぎる",
+			q:          strings.Repeat("夏", readingQLengthMax+1),
+			wantStatus: http.StatusBadRequest,
+			want: errorResponse{Errors: []errorDetail{
+				{Code: "out_of_range", Field: "q", Message: fmt.Sprintf("読みを推定する文字列は%d文字以下である必要があります", readingQLengthMax)},
+			}},
+		},
+		{
+			name:       "異常系: qがない",
+			q:          "",
+			wantStatus: http.StatusBadRequest,
+			want: errorResponse{Errors: []errorDetail{
+				{Code: "too_short", Field: "q", Message: "読みを推定する文字列を指定してください"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readings?q="+url.QueryEscape(tt.q), nil))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %v, want = %v", w.Code, tt.wantStatus)
+			}
+			// 成功時とエラー時でレスポンスの型が違うため、期待値と同じ型で読む
+			res := reflect.New(reflect.TypeOf(tt.want))
+			if err := json.NewDecoder(w.Body).Decode(res.Interface()); err != nil {
+				t.Fatal(err)
+			}
+			got := res.Elem().Interface()
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("GET /readings = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/author"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/duplicate"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/label"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/publish"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/reading"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+)
+
+// NewRouter はAPIのルーティングを設定する
+func NewRouter(
+	authors author.AuthorRepository,
+	labels label.LabelRepository,
+	publishes publish.PublishRepository,
+	suggester reading.Suggester,
+	duplicates duplicate.CandidateRepository,
+	merger duplicate.Merger,
+	clk clock.Clock,
+) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /authors", indexHandler(authors))
+	mux.Handle("GET /authors/{slug}", slugHandler(authors))
+	mux.Handle("GET /labels", indexHandler(labels))
+	mux.Handle("GET /labels/{slug}", slugHandler(labels))
+	mux.Handle("GET /publishers", indexHandler(publishes))
+	mux.Handle("GET /publishers/{slug}", slugHandler(publishes))
+	mux.Handle("GET /readings", readingHandler(suggester))
+	mux.Handle("GET /duplicates", duplicatesHandler(duplicates))
+	mux.Handle("POST /duplicates/{id}/merge", mergeDuplicateHandler(duplicates, merger, clk))
+	mux.Handle("POST /duplicates/{id}/dismiss", dismissDuplicateHandler(duplicates, clk))
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
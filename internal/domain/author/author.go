@@ -1,11 +1,12 @@
 package author
 
 import (
-	"fmt"
 	"time"
 	"unicode/utf8"
 
 	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/romaji"
 	"github.com/mitsu-yuki/shisho-backend/pkg/text"
 	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
 )
@@ -16,9 +17,11 @@ const (
 )
 
 type Author struct {
-	id           string
-	name         string
-	namePhonic   string
+	id         string
+	name       string
+	namePhonic string
+	// canonicalID はペンネームや表記揺れの著者が指す本人の著者ID。本人の場合は nil
+	canonicalID  *string
 	createAt     time.Time
 	lastUpdateAt time.Time
 	deletedAt    *time.Time
@@ -28,38 +31,58 @@ func newAuthor(
 	id string,
 	name string,
 	namePhonic string,
+	canonicalID *string,
 	createAt time.Time,
 	lastUpdateAt time.Time,
 	deletedAt *time.Time,
 ) (*Author, error) {
+	// IDのバリデーション
+	if !ulid.IsValid(id) {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "id", "author.id.invalid")
+	}
+
 	// 名前のバリデーション
 	if utf8.RuneCountInString(name) < nameLengthMin {
-		return nil, errDomain.NewError(fmt.Sprintf("著者名は%d文字以上である必要があります", nameLengthMin))
+		return nil, errDomain.NewMessageError(errDomain.CodeTooShort, "name", "author.name.too_short", nameLengthMin)
 	}
 
+	// 読みはひらがなや半角カナで入力されてもカタカナに揃える
+	namePhonic = text.NormalizeReading(namePhonic)
+
 	// 名前(読み)のバリデーション
 	if utf8.RuneCountInString(namePhonic) < namePhonicLengthMin {
-		return nil, errDomain.NewError(fmt.Sprintf("著者名読みは%d文字以上である必要があります", nameLengthMin))
+		return nil, errDomain.NewMessageError(errDomain.CodeTooShort, "namePhonic", "author.name_phonic.too_short", namePhonicLengthMin)
 	}
 
-	if !text.IsKatakana(namePhonic) {
-		return nil, errDomain.NewError("著者名読みはカタカナである必要があります")
+	if !text.IsReading(namePhonic) {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "namePhonic", "author.name_phonic.not_katakana")
+	}
+
+	// 本人の著者IDのバリデーション
+	if canonicalID != nil {
+		if !ulid.IsValid(*canonicalID) {
+			return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "canonicalID", "author.id.invalid")
+		}
+		if *canonicalID == id {
+			return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "canonicalID", "author.canonical.self")
+		}
 	}
 
 	// 日付のバリデーション(lastUpdateAtのほうが後か)
 	if lastUpdateAt.Before(createAt) {
-		return nil, errDomain.NewError("更新日は作成日よりも後である必要があります")
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "lastUpdateAt", "update_time.before_create")
 	}
 
 	// 削除フラグが立ってない もしくは 削除日は作成日よりも後であるか
 	if deletedAt != nil && deletedAt.Before(createAt) {
-		return nil, errDomain.NewError("削除日は作成日よりも後である必要があります")
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "deletedAt", "delete_time.before_create")
 	}
 
 	return &Author{
 		id:           id,
 		name:         name,
 		namePhonic:   namePhonic,
+		canonicalID:  canonicalID,
 		createAt:     createAt,
 		lastUpdateAt: lastUpdateAt,
 		deletedAt:    deletedAt,
@@ -70,19 +93,147 @@ func Reconstruct(
 	id string,
 	name string,
 	namePhonic string,
+	canonicalID *string,
 	createAt time.Time,
 	lastUpdateAt time.Time,
 	deletedAt *time.Time,
 ) (*Author, error) {
-	return newAuthor(id, name, namePhonic, createAt, lastUpdateAt, deletedAt)
+	return newAuthor(id, name, namePhonic, canonicalID, createAt, lastUpdateAt, deletedAt)
 }
 
 func NewAuthor(
+	clk clock.Clock,
 	name string,
 	namePhonic string,
-	createAt time.Time,
-	lastUpdateAt time.Time,
-	deletedAt *time.Time,
 ) (*Author, error) {
-	return newAuthor(ulid.NewULID(), name, namePhonic, createAt, lastUpdateAt, deletedAt)
+	now := clk.Now()
+	return newAuthor(ulid.NewULID(), name, namePhonic, nil, now, now, nil)
+}
+
+func (a *Author) ID() string {
+	return a.id
+}
+
+func (a *Author) Name() string {
+	return a.name
+}
+
+func (a *Author) NamePhonic() string {
+	return a.namePhonic
+}
+
+// NameRomaji は読みをヘボン式のローマ字にしたもの
+func (a *Author) NameRomaji() string {
+	return romaji.FromKatakana(a.namePhonic)
+}
+
+// Slug はURLに使うローマ字。同じ読みであれば同じ値になる
+func (a *Author) Slug() string {
+	return romaji.Slug(a.NameRomaji())
+}
+
+// CanonicalID はペンネームの場合に本人の著者IDを返す。本人の場合は nil
+func (a *Author) CanonicalID() *string {
+	return a.canonicalID
+}
+
+// IsAlias は他の著者のペンネームや表記揺れか
+func (a *Author) IsAlias() bool {
+	return a.canonicalID != nil
+}
+
+func (a *Author) CreateAt() time.Time {
+	return a.createAt
+}
+
+func (a *Author) LastUpdateAt() time.Time {
+	return a.lastUpdateAt
+}
+
+func (a *Author) DeletedAt() *time.Time {
+	return a.deletedAt
+}
+
+// Rename は名前と読みを変更する
+func (a *Author) Rename(clk clock.Clock, name string, namePhonic string) error {
+	return a.update(clk, func(next *Author) {
+		next.name = name
+		next.namePhonic = namePhonic
+	})
+}
+
+func (a *Author) IsDeleted() bool {
+	return a.deletedAt != nil
+}
+
+// Delete は論理削除する
+func (a *Author) Delete(clk clock.Clock) error {
+	if a.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "already_deleted")
+	}
+	return a.deleteAt(clk.Now())
+}
+
+func (a *Author) deleteAt(now time.Time) error {
+	return a.updateAt(now, func(next *Author) {
+		next.deletedAt = &now
+	})
+}
+
+// Restore は論理削除を取り消す
+func (a *Author) Restore(clk clock.Clock) error {
+	if !a.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "not_deleted")
+	}
+	return a.update(clk, func(next *Author) {
+		next.deletedAt = nil
+	})
+}
+
+// SetCanonical は canonical のペンネームとして登録する
+// 別名の別名は作らず、本人は常に1段でたどれるようにする
+// a 自身が別名を持つかはエンティティだけでは分からないため、リポジトリの Save で確かめる
+func (a *Author) SetCanonical(clk clock.Clock, canonical *Author) error {
+	return a.setCanonicalAt(clk.Now(), canonical)
+}
+
+func (a *Author) setCanonicalAt(now time.Time, canonical *Author) error {
+	if canonical.IsAlias() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "canonicalID", "author.canonical.is_alias")
+	}
+	if canonical.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "canonicalID", "author.canonical.deleted")
+	}
+	canonicalID := canonical.id
+	return a.updateAt(now, func(next *Author) {
+		next.canonicalID = &canonicalID
+	})
+}
+
+// ClearCanonical はペンネームの登録を外して本人とする
+func (a *Author) ClearCanonical(clk clock.Clock) error {
+	if !a.IsAlias() {
+		return nil
+	}
+	return a.update(clk, func(next *Author) {
+		next.canonicalID = nil
+	})
+}
+
+// update は変更を加えた著者をコンストラクタと同じバリデーションにかけ、
+// 問題がなければ更新日を現在時刻にして反映する
+func (a *Author) update(clk clock.Clock, change func(next *Author)) error {
+	return a.updateAt(clk.Now(), change)
+}
+
+// updateAt は更新日を now にして反映する。削除日など他の日時と揃える場合に使う
+func (a *Author) updateAt(now time.Time, change func(next *Author)) error {
+	next := *a
+	change(&next)
+	updated, err := newAuthor(next.id, next.name, next.namePhonic, next.canonicalID, next.createAt, now, next.deletedAt)
+	if err != nil {
+		return err
+	}
+	*a = *updated
+	return nil
 }
@@ -0,0 +1,40 @@
+package author
+
+import (
+	"context"
+	"time"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/pkg/gojuon"
+)
+
+// ErrHasAliases は別名を持つ著者を他の著者の別名として Save しようとした場合に返す
+// 別名の別名を作らないため、別名を付け替えたい場合は SaveMerge を使う
+var ErrHasAliases = errDomain.NewMessageError(errDomain.CodeConflict, "canonicalID", "author.canonical.has_aliases")
+
+type AuthorRepository interface {
+	Save(ctx context.Context, author *Author) error
+	FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*Author, error)
+	FindAll(ctx context.Context, opts ...repository.FindOption) ([]*Author, error)
+	// FindAllOrderByReading は読みの五十音順に返す
+	FindAllOrderByReading(ctx context.Context, opts ...repository.FindOption) ([]*Author, error)
+	// FindByIndexRow は読みが五十音の索引の row 行に入るものを五十音順に返す
+	FindByIndexRow(ctx context.Context, row gojuon.Row, opts ...repository.FindOption) ([]*Author, error)
+	// FindByRomaji はローマ字の読みに q を含むものを五十音順に返す
+	// q は空白やハイフン、長音の書き方の違いを無視して比べる。それらを除いて空になる q には何も返さない
+	FindByRomaji(ctx context.Context, q string, opts ...repository.FindOption) ([]*Author, error)
+	// FindBySlug は Slug が slug と一致するものを返す。同じ読みのものがあれば複数返す
+	FindBySlug(ctx context.Context, slug string, opts ...repository.FindOption) ([]*Author, error)
+	// FindAliases は canonicalID のペンネームや表記揺れの著者を五十音順に返す
+	FindAliases(ctx context.Context, canonicalID string, opts ...repository.FindOption) ([]*Author, error)
+	// SaveMerge は統合した著者と履歴を保存し、書籍のクレジットと別名を本人の著者に付け替える
+	// これらは1つのトランザクションで行う
+	SaveMerge(ctx context.Context, duplicate *Author, record MergeRecord) error
+	// FindMergeRecords は authorID が統合元または統合先の履歴を古い順に返す
+	FindMergeRecords(ctx context.Context, authorID string) ([]MergeRecord, error)
+	// Delete は行を物理削除する。論理削除はエンティティのDeleteの後にSaveする
+	Delete(ctx context.Context, id string) error
+	// Purge は before より前に論理削除された行を物理削除し、削除した件数を返す
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
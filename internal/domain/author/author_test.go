@@ -1,21 +1,62 @@
 package author
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
 )
 
-func TestNewAuthor(t *testing.T) {
+func Test_newAuthor_tooShortArgs(t *testing.T) {
+	// 最小文字数はメッセージの引数として他の言語のメッセージにも使われるため、項目ごとの値を渡す
+	now := time.Now()
+	tests := []struct {
+		name       string
+		authorName string
+		namePhonic string
+		want       *errDomain.Error
+	}{
+		{
+			name:       "異常系: name",
+			authorName: "",
+			namePhonic: "テスト",
+			want:       errDomain.NewMessageError(errDomain.CodeTooShort, "name", "author.name.too_short", nameLengthMin),
+		},
+		{
+			name:       "異常系: namePhonic",
+			authorName: "test",
+			namePhonic: "",
+			want:       errDomain.NewMessageError(errDomain.CodeTooShort, "namePhonic", "author.name_phonic.too_short", namePhonicLengthMin),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newAuthor(ulid.NewULID(), tt.authorName, tt.namePhonic, nil, now, now, nil)
+			var got *errDomain.Error
+			if !errors.As(err, &got) {
+				t.Fatalf("newAuthor() error = %v, want *errDomain.Error", err)
+			}
+			if diff := cmp.Diff(got, tt.want, cmp.AllowUnexported(errDomain.Error{})); diff != "" {
+				t.Errorf("newAuthor() error = %#v, want = %#v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func Test_newAuthor(t *testing.T) {
 	now := time.Now()
 	earlier := now.Add(-1 * time.Hour)
 	later := now.Add(1 * time.Hour)
 	type args struct {
 		name         string
 		namePhonic   string
+		canonicalID  *string
 		createAt     time.Time
 		lastUpdateAt time.Time
 		deletedAt    *time.Time
@@ -47,6 +88,44 @@ func TestNewAuthor(t *testing.T) {
 			wantErr:    false,
 			wantErrStr: "",
 		},
+		{
+			name: "正常系: ひらがなをカタカナに揃える",
+			args: args{
+				name:         "test",
+				namePhonic:   "ジョージ・おーうぇる",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want: &Author{
+				name:         "test",
+				namePhonic:   "ジョージ・オーウェル",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			wantErr:    false,
+			wantErrStr: "",
+		},
+		{
+			name: "正常系: 半角カナと空白を揃える",
+			args: args{
+				name:         "test",
+				namePhonic:   " ﾑﾗｶﾐ  ﾊﾙｷ ",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want: &Author{
+				name:         "test",
+				namePhonic:   "ムラカミ　ハルキ",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			wantErr:    false,
+			wantErrStr: "",
+		},
 		{
 			name: "異常系: nameが不正",
 			args: args{
@@ -77,7 +156,7 @@ func TestNewAuthor(t *testing.T) {
 			name: "異常系: namePhonicがカタカナでない",
 			args: args{
 				name:         "test",
-				namePhonic:   "てすと",
+				namePhonic:   "test",
 				createAt:     now,
 				lastUpdateAt: later,
 				deletedAt:    nil,
@@ -112,12 +191,26 @@ func TestNewAuthor(t *testing.T) {
 			wantErr:    true,
 			wantErrStr: "削除日は作成日よりも後である必要があります",
 		},
+		{
+			name: "異常系: 本人の著者IDが不正",
+			args: args{
+				name:         "test",
+				namePhonic:   "テスト",
+				canonicalID:  ptr("canonical"),
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "著者IDが不正です",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewAuthor(tt.args.name, tt.args.namePhonic, tt.args.createAt, tt.args.lastUpdateAt, tt.args.deletedAt)
+			got, err := newAuthor(ulid.NewULID(), tt.args.name, tt.args.namePhonic, tt.args.canonicalID, tt.args.createAt, tt.args.lastUpdateAt, tt.args.deletedAt)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NewAuthor() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("newAuthor() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			if err != nil && err.Error() != tt.wantErrStr {
 				if diff := cmp.Diff(err.Error(), tt.wantErrStr); diff != "" {
@@ -131,7 +224,203 @@ func TestNewAuthor(t *testing.T) {
 			)
 
 			if diff != "" {
-				t.Errorf("NewAuthor() = %v, want = %v.\n error is %s", got, tt.want, diff)
+				t.Errorf("newAuthor() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestNewAuthor(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	got, err := NewAuthor(clk, "test", "テスト")
+	if err != nil {
+		t.Fatalf("NewAuthor() error = %v", err)
+	}
+	if !ulid.IsValid(got.ID()) {
+		t.Errorf("ID() = %s, want ULID", got.ID())
+	}
+	if !got.CreateAt().Equal(now) || !got.LastUpdateAt().Equal(now) {
+		t.Errorf("CreateAt() = %v, LastUpdateAt() = %v, want = %v", got.CreateAt(), got.LastUpdateAt(), now)
+	}
+	if got.IsDeleted() {
+		t.Errorf("IsDeleted() = true, want = false")
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		id         string
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:    "正常系",
+			id:      ulid.NewULID(),
+			wantErr: false,
+		},
+		{
+			name:       "異常系: IDが不正",
+			id:         "id",
+			wantErr:    true,
+			wantErrStr: "著者IDが不正です",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Reconstruct(tt.id, "test", "テスト", nil, now, now, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Reconstruct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if err == nil && got.ID() != tt.id {
+				t.Errorf("Reconstruct().ID() = %s, want = %s", got.ID(), tt.id)
+			}
+		})
+	}
+}
+
+func TestAuthor_Rename(t *testing.T) {
+	now := time.Now()
+	later := now.Add(1 * time.Hour)
+	tests := []struct {
+		name           string
+		newName        string
+		newNamePhonic  string
+		wantName       string
+		wantLastUpdate time.Time
+		wantErr        bool
+		wantErrStr     string
+	}{
+		{
+			name:           "正常系",
+			newName:        "new",
+			newNamePhonic:  "アタラシイ",
+			wantName:       "new",
+			wantLastUpdate: later,
+			wantErr:        false,
+		},
+		{
+			name:           "異常系: nameが不正",
+			newName:        "",
+			newNamePhonic:  "アタラシイ",
+			wantName:       "test",
+			wantLastUpdate: now,
+			wantErr:        true,
+			wantErrStr:     fmt.Sprintf("著者名は%d文字以上である必要があります", nameLengthMin),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewAuthor(clock.NewFixed(now), "test", "テスト")
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = v.Rename(clock.NewFixed(later), tt.newName, tt.newNamePhonic)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Rename() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if v.Name() != tt.wantName {
+				t.Errorf("Name() = %v, want = %v", v.Name(), tt.wantName)
+			}
+			if !v.LastUpdateAt().Equal(tt.wantLastUpdate) {
+				t.Errorf("LastUpdateAt() = %v, want = %v", v.LastUpdateAt(), tt.wantLastUpdate)
+			}
+		})
+	}
+}
+
+func TestAuthor_DeleteRestore(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	v := func() *Author {
+		a, err := NewAuthor(clock.NewFixed(now), "test", "テスト")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}()
+
+	if err := v.Restore(clk); err == nil || err.Error() != "削除されていません" {
+		t.Errorf("Restore() error = %v, want 削除されていません", err)
+	}
+
+	clk.Advance(1 * time.Hour)
+	if err := v.Delete(clk); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !v.IsDeleted() || !v.DeletedAt().Equal(clk.Now()) || !v.LastUpdateAt().Equal(clk.Now()) {
+		t.Errorf("DeletedAt() = %v, LastUpdateAt() = %v, want = %v", v.DeletedAt(), v.LastUpdateAt(), clk.Now())
+	}
+	if err := v.Delete(clk); err == nil || err.Error() != "既に削除されています" {
+		t.Errorf("Delete() error = %v, want 既に削除されています", err)
+	}
+
+	clk.Advance(1 * time.Hour)
+	if err := v.Restore(clk); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if v.IsDeleted() || !v.LastUpdateAt().Equal(clk.Now()) {
+		t.Errorf("DeletedAt() = %v, LastUpdateAt() = %v, want = nil, %v", v.DeletedAt(), v.LastUpdateAt(), clk.Now())
+	}
+}
+
+func TestAuthor_NameRomaji(t *testing.T) {
+	tests := []struct {
+		name       string
+		namePhonic string
+		wantRomaji string
+		wantSlug   string
+	}{
+		{
+			name:       "正常系",
+			namePhonic: "オダ エイイチロウ",
+			wantRomaji: "oda eiichiro",
+			wantSlug:   "oda-eiichiro",
+		},
+		{
+			name:       "正常系: 拗音と長音",
+			namePhonic: "ナツメ ソウセキ",
+			wantRomaji: "natsume soseki",
+			wantSlug:   "natsume-soseki",
+		},
+		{
+			name:       "正常系: 促音",
+			namePhonic: "ハットリ ケイイチロウ",
+			wantRomaji: "hattori keiichiro",
+			wantSlug:   "hattori-keiichiro",
+		},
+		{
+			name:       "正常系: 中黒と長音符",
+			namePhonic: "ジョージ・オーウェル",
+			wantRomaji: "joji oweru",
+			wantSlug:   "joji-oweru",
+		},
+		{
+			name:       "正常系: 撥音はnで書く",
+			namePhonic: "ホンマ シンペイ",
+			wantRomaji: "honma shinpei",
+			wantSlug:   "honma-shinpei",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewAuthor(clock.NewFixed(time.Now()), "test", tt.namePhonic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := a.NameRomaji(); got != tt.wantRomaji {
+				t.Errorf("NameRomaji() = %v, want = %v", got, tt.wantRomaji)
+			}
+			if got := a.Slug(); got != tt.wantSlug {
+				t.Errorf("Slug() = %v, want = %v", got, tt.wantSlug)
 			}
 		})
 	}
@@ -0,0 +1,72 @@
+package author
+
+import (
+	"time"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+// MergeRecord は重複した著者を本人の著者に統合した履歴
+type MergeRecord struct {
+	id          string
+	duplicateID string
+	canonicalID string
+	mergedAt    time.Time
+}
+
+func ReconstructMergeRecord(id string, duplicateID string, canonicalID string, mergedAt time.Time) MergeRecord {
+	return MergeRecord{
+		id:          id,
+		duplicateID: duplicateID,
+		canonicalID: canonicalID,
+		mergedAt:    mergedAt,
+	}
+}
+
+func (m MergeRecord) ID() string {
+	return m.id
+}
+
+func (m MergeRecord) DuplicateID() string {
+	return m.duplicateID
+}
+
+func (m MergeRecord) CanonicalID() string {
+	return m.canonicalID
+}
+
+func (m MergeRecord) MergedAt() time.Time {
+	return m.mergedAt
+}
+
+// Merge は duplicate を canonical に統合する
+// duplicate は canonical の別名として論理削除し、統合の履歴を返す
+// 書籍のクレジットの付け替えはリポジトリの SaveMerge で同じトランザクションで行う
+func Merge(clk clock.Clock, duplicate *Author, canonical *Author) (MergeRecord, error) {
+	if duplicate.id == canonical.id {
+		return MergeRecord{}, errDomain.NewMessageError(errDomain.CodeInvalid, "canonicalID", "author.canonical.self")
+	}
+	if duplicate.IsDeleted() {
+		return MergeRecord{}, errDomain.NewMessageError(errDomain.CodeConflict, "", "already_deleted")
+	}
+
+	// 別名への変更・削除・統合の履歴を同じ日時にする
+	now := clk.Now()
+	next := *duplicate
+	if err := next.setCanonicalAt(now, canonical); err != nil {
+		return MergeRecord{}, err
+	}
+	if err := next.deleteAt(now); err != nil {
+		return MergeRecord{}, err
+	}
+	*duplicate = next
+
+	return MergeRecord{
+		id:          ulid.NewULID(),
+		duplicateID: duplicate.id,
+		canonicalID: canonical.id,
+		mergedAt:    now,
+	}, nil
+}
@@ -0,0 +1,168 @@
+package author
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+)
+
+func TestMerge(t *testing.T) {
+	now := time.Now()
+	newAuthors := func(t *testing.T) (*Author, *Author) {
+		t.Helper()
+		duplicate, err := NewAuthor(clock.NewFixed(now), "尾田栄一郎", "オダ エイイチロウ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		canonical, err := NewAuthor(clock.NewFixed(now), "尾田 栄一郎", "オダ エイイチロウ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return duplicate, canonical
+	}
+	tests := []struct {
+		name       string
+		prepare    func(t *testing.T, clk *clock.FixedClock, duplicate, canonical *Author) (*Author, *Author)
+		wantErrStr string
+	}{
+		{
+			name: "正常系",
+		},
+		{
+			name: "異常系: 自分自身に統合",
+			prepare: func(t *testing.T, clk *clock.FixedClock, duplicate, canonical *Author) (*Author, *Author) {
+				return duplicate, duplicate
+			},
+			wantErrStr: "自分自身を本人の著者にはできません",
+		},
+		{
+			name: "異常系: 統合先が別名",
+			prepare: func(t *testing.T, clk *clock.FixedClock, duplicate, canonical *Author) (*Author, *Author) {
+				other, _ := newAuthors(t)
+				if err := canonical.SetCanonical(clk, other); err != nil {
+					t.Fatal(err)
+				}
+				return duplicate, canonical
+			},
+			wantErrStr: "別名の著者を本人の著者にはできません",
+		},
+		{
+			name: "異常系: 統合先が削除済み",
+			prepare: func(t *testing.T, clk *clock.FixedClock, duplicate, canonical *Author) (*Author, *Author) {
+				if err := canonical.Delete(clk); err != nil {
+					t.Fatal(err)
+				}
+				return duplicate, canonical
+			},
+			wantErrStr: "削除された著者を本人の著者にはできません",
+		},
+		{
+			name: "異常系: 統合元が削除済み",
+			prepare: func(t *testing.T, clk *clock.FixedClock, duplicate, canonical *Author) (*Author, *Author) {
+				if err := duplicate.Delete(clk); err != nil {
+					t.Fatal(err)
+				}
+				return duplicate, canonical
+			},
+			wantErrStr: "既に削除されています",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := clock.NewFixed(now)
+			duplicate, canonical := newAuthors(t)
+			if tt.prepare != nil {
+				duplicate, canonical = tt.prepare(t, clk, duplicate, canonical)
+			}
+			clk.Advance(1 * time.Hour)
+			before := *duplicate
+
+			record, err := Merge(clk, duplicate, canonical)
+			if tt.wantErrStr != "" {
+				if err == nil || err.Error() != tt.wantErrStr {
+					t.Errorf("Merge() error = %v, wantErrStr %s", err, tt.wantErrStr)
+				}
+				if duplicate.IsDeleted() != before.IsDeleted() || duplicate.IsAlias() != before.IsAlias() {
+					t.Errorf("Merge() が失敗しても統合元が変更されています")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Merge() error = %v", err)
+			}
+			if !duplicate.IsDeleted() || duplicate.CanonicalID() == nil || *duplicate.CanonicalID() != canonical.ID() {
+				t.Errorf("DeletedAt() = %v, CanonicalID() = %v, want deleted alias of %s", duplicate.DeletedAt(), duplicate.CanonicalID(), canonical.ID())
+			}
+			if record.DuplicateID() != duplicate.ID() || record.CanonicalID() != canonical.ID() || !record.MergedAt().Equal(clk.Now()) {
+				t.Errorf("Merge() = %+v, want duplicate %s, canonical %s, mergedAt %v", record, duplicate.ID(), canonical.ID(), clk.Now())
+			}
+		})
+	}
+}
+
+func TestAuthor_ClearCanonical(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	alias, err := NewAuthor(clk, "ペンネーム", "ペンネーム")
+	if err != nil {
+		t.Fatal(err)
+	}
+	canonical, err := NewAuthor(clk, "本名", "ホンミョウ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clk.Advance(1 * time.Hour)
+	if err := alias.SetCanonical(clk, canonical); err != nil {
+		t.Fatalf("SetCanonical() error = %v", err)
+	}
+	if !alias.IsAlias() || *alias.CanonicalID() != canonical.ID() || !alias.LastUpdateAt().Equal(clk.Now()) {
+		t.Errorf("CanonicalID() = %v, LastUpdateAt() = %v, want = %s, %v", alias.CanonicalID(), alias.LastUpdateAt(), canonical.ID(), clk.Now())
+	}
+	if err := canonical.SetCanonical(clk, canonical); err == nil || err.Error() != "自分自身を本人の著者にはできません" {
+		t.Errorf("SetCanonical() error = %v, want 自分自身を本人の著者にはできません", err)
+	}
+
+	clk.Advance(1 * time.Hour)
+	if err := alias.ClearCanonical(clk); err != nil {
+		t.Fatalf("ClearCanonical() error = %v", err)
+	}
+	if alias.IsAlias() || !alias.LastUpdateAt().Equal(clk.Now()) {
+		t.Errorf("CanonicalID() = %v, LastUpdateAt() = %v, want = nil, %v", alias.CanonicalID(), alias.LastUpdateAt(), clk.Now())
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+// tickingClock は呼び出すたびに1秒進む時計
+type tickingClock struct {
+	now time.Time
+}
+
+func (c *tickingClock) Now() time.Time {
+	c.now = c.now.Add(time.Second)
+	return c.now
+}
+
+func TestMerge_SameTime(t *testing.T) {
+	clk := &tickingClock{now: time.Now()}
+	duplicate, err := NewAuthor(clk, "尾田栄一郎", "オダ エイイチロウ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	canonical, err := NewAuthor(clk, "尾田 栄一郎", "オダ エイイチロウ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record, err := Merge(clk, duplicate, canonical)
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if !duplicate.LastUpdateAt().Equal(record.MergedAt()) || !duplicate.DeletedAt().Equal(record.MergedAt()) {
+		t.Errorf("LastUpdateAt() = %v, DeletedAt() = %v, MergedAt() = %v, want same time", duplicate.LastUpdateAt(), duplicate.DeletedAt(), record.MergedAt())
+	}
+}
@@ -0,0 +1,41 @@
+package authorrole
+
+import (
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+)
+
+// Role は書籍の奥付に記載される著者の役割
+type Role string
+
+const (
+	// Writer は文章や物語を書いた著者。役割の指定がない既存の著者もこれになる
+	Writer      Role = "作"
+	Artist      Role = "画"
+	Original    Role = "原作"
+	Translator  Role = "翻訳"
+	Illustrator Role = "イラスト"
+)
+
+// Roles は奥付に記載する順に並べた役割
+var Roles = []Role{Original, Writer, Artist, Illustrator, Translator}
+
+func NewRole(s string) (Role, error) {
+	role := Role(s)
+	if !role.IsValid() {
+		return "", errDomain.NewMessageError(errDomain.CodeInvalid, "role", "author.role.invalid")
+	}
+	return role, nil
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) IsValid() bool {
+	for _, role := range Roles {
+		if role == r {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package authorrole
+
+import "testing"
+
+func TestNewRole(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		want       Role
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:    "正常系",
+			s:       "イラスト",
+			want:    Illustrator,
+			wantErr: false,
+		},
+		{
+			name:       "異常系: 未定義の役割",
+			s:          "監修",
+			want:       "",
+			wantErr:    true,
+			wantErrStr: "著者の役割が不正です",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRole(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewRole() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if got != tt.want {
+				t.Errorf("NewRole() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
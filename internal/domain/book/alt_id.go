@@ -0,0 +1,52 @@
+package book
+
+import (
+	"unicode/utf8"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+)
+
+const (
+	eventLengthMin  = 1
+	circleLengthMin = 1
+	itemLengthMin   = 1
+)
+
+// AltID はISBNのない書籍の代替識別子
+// 同人誌は頒布したイベントとサークル、サークルが付けた頒布物の品番で識別する
+// 同じイベントで同じサークルが複数の頒布物を出すため、品番で区別する
+type AltID struct {
+	event  string
+	circle string
+	item   string
+}
+
+func NewAltID(event string, circle string, item string) (AltID, error) {
+	if utf8.RuneCountInString(event) < eventLengthMin {
+		return AltID{}, errDomain.NewMessageError(errDomain.CodeTooShort, "event", "book.event.too_short", eventLengthMin)
+	}
+	if utf8.RuneCountInString(circle) < circleLengthMin {
+		return AltID{}, errDomain.NewMessageError(errDomain.CodeTooShort, "circle", "book.circle.too_short", circleLengthMin)
+	}
+	if utf8.RuneCountInString(item) < itemLengthMin {
+		return AltID{}, errDomain.NewMessageError(errDomain.CodeTooShort, "item", "book.item.too_short", itemLengthMin)
+	}
+	return AltID{event: event, circle: circle, item: item}, nil
+}
+
+func (a AltID) Event() string {
+	return a.event
+}
+
+func (a AltID) Circle() string {
+	return a.circle
+}
+
+// Item はサークルが頒布物に付けた品番(新刊A、C105-01など)
+func (a AltID) Item() string {
+	return a.item
+}
+
+func (a AltID) String() string {
+	return a.event + "/" + a.circle + "/" + a.item
+}
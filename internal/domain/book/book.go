@@ -1,12 +1,16 @@
 package book
 
 import (
-	"fmt"
+	"slices"
 	"time"
 	"unicode/utf8"
 
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/authorrole"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/bookjan"
 	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
-	"github.com/mitsu-yuki/shisho-backend/pkg/checkdigit"
+	isbnDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/isbn"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/text"
 	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
 )
 
@@ -19,14 +23,20 @@ const (
 )
 
 type Book struct {
-	id           string
-	isbn         *string
-	labelID      string
-	publishID    string
-	title        string
+	id        string
+	isbn      *isbnDomain.ISBN
+	altID     *AltID
+	labelID   string
+	publishID string
+	sizeID    string
+	title     string
+	// titlePhonic はタイトルの読みで、未入力の場合は空文字
+	titlePhonic  string
 	authorIDs    BookAuthors
+	tagIDs       BookTags
 	releaseDay   time.Time
 	price        int
+	cCode        *bookjan.CCode
 	explain      string
 	createAt     time.Time
 	lastUpdateAt time.Time
@@ -35,70 +45,106 @@ type Book struct {
 
 func newBook(
 	id string,
-	isbn *string,
+	isbn *isbnDomain.ISBN,
+	altID *AltID,
 	labelID string,
 	publishID string,
+	sizeID string,
 	title string,
+	titlePhonic string,
 	authorIDs []BookAuthor,
+	tagIDs []BookTag,
 	releaseDay time.Time,
 	price int,
+	cCode *bookjan.CCode,
 	explain string,
 	createAt time.Time,
 	lastUpdateAt time.Time,
 	deletedAt *time.Time,
 ) (*Book, error) {
-	// ISBNがある場合には有効なISBNか調べる
-	if isbn != nil && !checkdigit.ISBN13IsValid(*isbn) {
-		return nil, errDomain.NewError("ISBNが不正です")
+	// すべての項目を検証し、不正な項目をまとめて返す
+	var verr errDomain.ValidationError
+
+	// 書籍IDのバリデーション
+	if !ulid.IsValid(id) {
+		verr.Add(errDomain.NewMessageError(errDomain.CodeInvalid, "id", "book.id.invalid"))
+	}
+
+	// ISBNのない書籍のみ代替識別子を持てる
+	if isbn != nil && altID != nil {
+		verr.Add(errDomain.NewMessageError(errDomain.CodeConflict, "altID", "book.alt_id.conflict"))
 	}
 
 	// レーベルIDのバリデーション
 	if !ulid.IsValid(labelID) {
-		return nil, errDomain.NewError("レーベルIDが不正です")
+		verr.Add(errDomain.NewMessageError(errDomain.CodeInvalid, "labelID", "label.id.invalid"))
 	}
 
 	// 出版社IDのバリデーション
 	if !ulid.IsValid(publishID) {
-		return nil, errDomain.NewError("出版社IDが不正です")
+		verr.Add(errDomain.NewMessageError(errDomain.CodeInvalid, "publishID", "publish.id.invalid"))
+	}
+
+	// 判型IDのバリデーション
+	if !ulid.IsValid(sizeID) {
+		verr.Add(errDomain.NewMessageError(errDomain.CodeInvalid, "sizeID", "size.id.invalid"))
 	}
 
 	// タイトルのバリデーション
 	if utf8.RuneCountInString(title) < titleLengthMin {
-		return nil, errDomain.NewError(fmt.Sprintf("タイトル名は%d文字以上である必要があります", titleLengthMin))
+		verr.Add(errDomain.NewMessageError(errDomain.CodeTooShort, "title", "book.title.too_short", titleLengthMin))
+	}
+
+	// タイトルの読みは任意だが、入力された場合はカタカナに揃える
+	titlePhonic = text.NormalizeReading(titlePhonic)
+	if titlePhonic != "" && !text.IsReading(titlePhonic) {
+		verr.Add(errDomain.NewMessageError(errDomain.CodeInvalid, "titlePhonic", "book.title_phonic.not_katakana"))
 	}
 
 	// 著者リストIDのバリデーション
 	if len(authorIDs) < bookAuthorsLengthMin {
-		return nil, errDomain.NewError(fmt.Sprintf("著者は%d人以上である必要があります", bookAuthorsLengthMin))
+		verr.Add(errDomain.NewMessageError(errDomain.CodeTooShort, "authorIDs", "book.authors.too_short", bookAuthorsLengthMin))
 	}
+	verr.Add(BookAuthors(authorIDs).validate())
+
+	// タグリストのバリデーション
+	verr.Add(BookTags(tagIDs).validate())
 
 	// 発売日のバリデーション
 	if releaseDay.IsZero() {
-		return nil, errDomain.NewError("発売日はゼロ値以外である必要があります")
+		verr.Add(errDomain.NewMessageError(errDomain.CodeInvalid, "releaseDay", "book.release_day.zero"))
 	}
 
 	// 金額のバリデーション
 	if price < priceMin {
-		return nil, errDomain.NewError(fmt.Sprintf("金額は%d円以上である必要があります", priceMin))
+		verr.Add(errDomain.NewMessageError(errDomain.CodeOutOfRange, "price", "book.price.out_of_range", priceMin))
 	}
 	// 日付のバリデーション(lastUpdateAtのほうが後か)
 	if lastUpdateAt.Before(createAt) {
-		return nil, errDomain.NewError("更新日は作成日よりも後である必要があります")
+		verr.Add(errDomain.NewMessageError(errDomain.CodeOutOfRange, "lastUpdateAt", "update_time.before_create"))
 	}
 
 	// 削除フラグが立ってない もしくは 削除日は作成日よりも後であるか
 	if deletedAt != nil && deletedAt.Before(createAt) {
-		return nil, errDomain.NewError("削除日は作成日よりも後である必要があります")
+		verr.Add(errDomain.NewMessageError(errDomain.CodeOutOfRange, "deletedAt", "delete_time.before_create"))
+	}
+	if err := verr.Err(); err != nil {
+		return nil, err
 	}
 	return &Book{
 		id:           id,
 		isbn:         isbn,
+		altID:        altID,
 		labelID:      labelID,
 		publishID:    publishID,
+		sizeID:       sizeID,
 		title:        title,
+		titlePhonic:  titlePhonic,
 		authorIDs:    authorIDs,
+		tagIDs:       tagIDs,
 		releaseDay:   releaseDay,
 		price:        price,
+		cCode:        cCode,
 		explain:      explain,
 		createAt:     createAt,
 		lastUpdateAt: lastUpdateAt,
@@ -109,68 +155,126 @@ func newBook(
 func Reconstruct(
 	id string,
 	isbn *string,
+	altID *AltID,
 	labelID string,
 	publishID string,
+	sizeID string,
 	title string,
+	titlePhonic string,
 	authorIDs []BookAuthor,
+	tagIDs []BookTag,
 	releaseDay time.Time,
 	price int,
+	cCode *bookjan.CCode,
 	explain string,
 	createAt time.Time,
 	lastUpdateAt time.Time,
 	deletedAt *time.Time,
 ) (*Book, error) {
-	return newBook(
+	code, isbnErr := parseISBN(isbn)
+	b, err := newBook(
 		id,
-		isbn,
+		code,
+		altID,
 		labelID,
 		publishID,
+		sizeID,
 		title,
+		titlePhonic,
 		authorIDs,
+		tagIDs,
 		releaseDay,
 		price,
+		cCode,
 		explain,
 		createAt,
 		lastUpdateAt,
 		deletedAt,
 	)
+	return joinISBNError(isbnErr, b, err)
 }
 
 func NewBook(
+	clk clock.Clock,
 	isbn *string,
+	altID *AltID,
 	labelID string,
 	publishID string,
+	sizeID string,
 	title string,
+	titlePhonic string,
 	authorIDs []BookAuthor,
+	tagIDs []BookTag,
 	releaseDay time.Time,
 	price int,
+	cCode *bookjan.CCode,
 	explain string,
-	createAt time.Time,
-	lastUpdateAt time.Time,
-	deletedAt *time.Time,
 ) (*Book, error) {
-	return newBook(
+	code, isbnErr := parseISBN(isbn)
+	now := clk.Now()
+	b, err := newBook(
 		ulid.NewULID(),
-		isbn,
+		code,
+		altID,
 		labelID,
 		publishID,
+		sizeID,
 		title,
+		titlePhonic,
 		authorIDs,
+		tagIDs,
 		releaseDay,
 		price,
+		cCode,
 		explain,
-		createAt,
-		lastUpdateAt,
-		deletedAt,
+		now,
+		now,
+		nil,
 	)
+	return joinISBNError(isbnErr, b, err)
+}
+
+// joinISBNError はISBNの解析エラーを他の項目のバリデーションエラーとまとめて返す
+func joinISBNError(isbnErr error, b *Book, err error) (*Book, error) {
+	if isbnErr == nil {
+		return b, err
+	}
+	var verr errDomain.ValidationError
+	verr.Add(isbnErr)
+	verr.Add(err)
+	return nil, verr.Err()
+}
+
+// parseISBN はISBNがある場合にはISBN-13に正規化する
+func parseISBN(isbn *string) (*isbnDomain.ISBN, error) {
+	if isbn == nil {
+		return nil, nil
+	}
+	code, err := isbnDomain.NewISBN(*isbn)
+	if err != nil {
+		return nil, err
+	}
+	return &code, nil
 }
 
 func (b *Book) ID() string {
 	return b.id
 }
 
-func (b *Book) ISBN() string {
-	return *b.isbn
+// ISBN は同人誌などISBNのない書籍では false を返す
+func (b *Book) ISBN() (isbnDomain.ISBN, bool) {
+	if b.isbn == nil {
+		return isbnDomain.ISBN{}, false
+	}
+	return *b.isbn, true
+}
+
+// AltID はISBNのない書籍の代替識別子を返す。設定されていない場合は false
+func (b *Book) AltID() (AltID, bool) {
+	if b.altID == nil {
+		return AltID{}, false
+	}
+	return *b.altID, true
 }
 
 func (b *Book) LabelID() string {
@@ -181,18 +285,88 @@ func (b *Book) PublishID() string {
 	return b.publishID
 }
 
+func (b *Book) SizeID() string {
+	return b.sizeID
+}
+
 func (b *Book) Title() string {
 	return b.title
 }
 
+func (b *Book) TitlePhonic() string {
+	return b.titlePhonic
+}
+
+// SortReading は並べ替えに使う読みを返す。読みがなければタイトルを返す
+func (b *Book) SortReading() string {
+	if b.titlePhonic == "" {
+		return b.title
+	}
+	return b.titlePhonic
+}
+
+// AuthorIDs はクレジットされている著者のIDを奥付の順に返す
+// 複数の役割でクレジットされている著者は最初の1回だけ含める
 func (b *Book) AuthorIDs() []string {
 	var authorIDs []string
 	for _, author := range b.authorIDs {
-		authorIDs = append(authorIDs, author.authorID)
+		if !slices.Contains(authorIDs, author.authorID) {
+			authorIDs = append(authorIDs, author.authorID)
+		}
 	}
 	return authorIDs
 }
 
+// Authors は役割付きのクレジットを奥付の順に返す
+func (b *Book) Authors() []BookAuthor {
+	return slices.Clone(b.authorIDs)
+}
+
+// AuthorIDsByRole は role でクレジットされている著者のIDを奥付の順に返す
+func (b *Book) AuthorIDsByRole(role authorrole.Role) []string {
+	var authorIDs []string
+	for _, author := range b.authorIDs {
+		if author.role == role {
+			authorIDs = append(authorIDs, author.authorID)
+		}
+	}
+	return authorIDs
+}
+
+func (b *Book) TagIDs() []string {
+	return b.tagIDs.TagIDs()
+}
+
+// AttachTag は書籍にタグを付与する
+func (b *Book) AttachTag(clk clock.Clock, tagID string) error {
+	return b.update(clk, func(next *Book) {
+		next.tagIDs = append(append(BookTags{}, b.tagIDs...), NewBookTag(tagID))
+	})
+}
+
+// DetachTag は書籍からタグを外す
+func (b *Book) DetachTag(clk clock.Clock, tagID string) error {
+	for i, tag := range b.tagIDs {
+		if tag.tagID == tagID {
+			return b.update(clk, func(next *Book) {
+				next.tagIDs = append(append(BookTags{}, b.tagIDs[:i]...), b.tagIDs[i+1:]...)
+			})
+		}
+	}
+	return errDomain.NewMessageError(errDomain.CodeConflict, "tagID", "book.tag.not_attached")
+}
+
+// ChangeTags は付与するタグをまとめて入れ替える
+func (b *Book) ChangeTags(clk clock.Clock, tagIDs []string) error {
+	tags := make(BookTags, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		tags = append(tags, NewBookTag(tagID))
+	}
+	return b.update(clk, func(next *Book) {
+		next.tagIDs = tags
+	})
+}
+
 func (b *Book) ReleaseDay() time.Time {
 	return b.releaseDay
 }
@@ -201,6 +375,14 @@ func (b *Book) Price() int {
 	return b.price
 }
 
+// CCode は書籍JANコードの分類コードを返す。未登録の場合は false
+func (b *Book) CCode() (bookjan.CCode, bool) {
+	if b.cCode == nil {
+		return bookjan.CCode{}, false
+	}
+	return *b.cCode, true
+}
+
 func (b *Book) Explain() string {
 	return b.explain
 }
@@ -217,10 +399,164 @@ func (b *Book) DeletedAt() *time.Time {
 	return b.deletedAt
 }
 
+func (b *Book) ChangeISBN(clk clock.Clock, isbn *string) error {
+	code, err := parseISBN(isbn)
+	if err != nil {
+		return err
+	}
+	return b.update(clk, func(next *Book) {
+		next.isbn = code
+	})
+}
+
+func (b *Book) ChangeAltID(clk clock.Clock, altID *AltID) error {
+	return b.update(clk, func(next *Book) {
+		next.altID = altID
+	})
+}
+
+func (b *Book) ChangeLabel(clk clock.Clock, labelID string) error {
+	return b.update(clk, func(next *Book) {
+		next.labelID = labelID
+	})
+}
+
+func (b *Book) ChangePublish(clk clock.Clock, publishID string) error {
+	return b.update(clk, func(next *Book) {
+		next.publishID = publishID
+	})
+}
+
+func (b *Book) ChangeSize(clk clock.Clock, sizeID string) error {
+	return b.update(clk, func(next *Book) {
+		next.sizeID = sizeID
+	})
+}
+
+// ChangeTitle はタイトルと読みを変更する
+func (b *Book) ChangeTitle(clk clock.Clock, title string, titlePhonic string) error {
+	return b.update(clk, func(next *Book) {
+		next.title = title
+		next.titlePhonic = titlePhonic
+	})
+}
+
+func (b *Book) ChangeAuthors(clk clock.Clock, authorIDs []BookAuthor) error {
+	return b.update(clk, func(next *Book) {
+		next.authorIDs = slices.Clone(authorIDs)
+	})
+}
+
+func (b *Book) ChangeReleaseDay(clk clock.Clock, releaseDay time.Time) error {
+	return b.update(clk, func(next *Book) {
+		next.releaseDay = releaseDay
+	})
+}
+
+func (b *Book) ChangePrice(clk clock.Clock, price int) error {
+	return b.update(clk, func(next *Book) {
+		next.price = price
+	})
+}
+
+// ApplyPriceCode は書籍JANコード2段目の読み取り結果から分類コードと本体価格を反映する
+func (b *Book) ApplyPriceCode(clk clock.Clock, code bookjan.PriceCode) error {
+	cCode := code.CCode()
+	return b.update(clk, func(next *Book) {
+		next.cCode = &cCode
+		next.price = code.Price()
+	})
+}
+
+func (b *Book) ChangeExplain(clk clock.Clock, explain string) error {
+	return b.update(clk, func(next *Book) {
+		next.explain = explain
+	})
+}
+
+func (b *Book) IsDeleted() bool {
+	return b.deletedAt != nil
+}
+
+// Delete は論理削除する
+func (b *Book) Delete(clk clock.Clock) error {
+	if b.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "already_deleted")
+	}
+	now := clk.Now()
+	return b.updateAt(now, func(next *Book) {
+		next.deletedAt = &now
+	})
+}
+
+// Restore は論理削除を取り消す
+func (b *Book) Restore(clk clock.Clock) error {
+	if !b.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "not_deleted")
+	}
+	return b.update(clk, func(next *Book) {
+		next.deletedAt = nil
+	})
+}
+
+// update は変更を加えた書籍をコンストラクタと同じバリデーションにかけ、
+// 問題がなければ更新日を現在時刻にして反映する
+func (b *Book) update(clk clock.Clock, change func(next *Book)) error {
+	return b.updateAt(clk.Now(), change)
+}
+
+// updateAt は更新日を now にして反映する。削除日など他の日時と揃える場合に使う
+func (b *Book) updateAt(now time.Time, change func(next *Book)) error {
+	next := *b
+	change(&next)
+	updated, err := newBook(
+		next.id,
+		next.isbn,
+		next.altID,
+		next.labelID,
+		next.publishID,
+		next.sizeID,
+		next.title,
+		next.titlePhonic,
+		next.authorIDs,
+		next.tagIDs,
+		next.releaseDay,
+		next.price,
+		next.cCode,
+		next.explain,
+		next.createAt,
+		now,
+		next.deletedAt,
+	)
+	if err != nil {
+		return err
+	}
+	*b = *updated
+	return nil
+}
+
 type BookAuthors []BookAuthor
 
+// BookAuthor は奥付の1件分のクレジット
+// 同じ著者が役割を変えて複数回クレジットされることがある(作・画など)
 type BookAuthor struct {
 	authorID string
+	role     authorrole.Role
+}
+
+func NewBookAuthor(authorID string, role authorrole.Role) BookAuthor {
+	return BookAuthor{
+		authorID: authorID,
+		role:     role,
+	}
+}
+
+func (b BookAuthor) AuthorID() string {
+	return b.authorID
+}
+
+func (b BookAuthor) Role() authorrole.Role {
+	return b.role
 }
 
 func (b BookAuthors) AuthorIDs() []string {
@@ -230,3 +566,60 @@ func (b BookAuthors) AuthorIDs() []string {
 	}
 	return authorIDs
 }
+
+// validate は著者IDと役割が有効で、同じ著者が同じ役割で重複していないか調べる
+func (b BookAuthors) validate() error {
+	seen := map[BookAuthor]struct{}{}
+	for _, author := range b {
+		if !ulid.IsValid(author.authorID) {
+			return errDomain.NewMessageError(errDomain.CodeInvalid, "authorIDs", "author.id.invalid")
+		}
+		if !author.role.IsValid() {
+			return errDomain.NewMessageError(errDomain.CodeInvalid, "authorIDs", "author.role.invalid")
+		}
+		if _, ok := seen[author]; ok {
+			return errDomain.NewMessageError(errDomain.CodeDuplicate, "authorIDs", "book.author.duplicate")
+		}
+		seen[author] = struct{}{}
+	}
+	return nil
+}
+
+type BookTags []BookTag
+
+type BookTag struct {
+	tagID string
+}
+
+func NewBookTag(tagID string) BookTag {
+	return BookTag{
+		tagID: tagID,
+	}
+}
+
+func (b BookTag) TagID() string {
+	return b.tagID
+}
+
+func (b BookTags) TagIDs() []string {
+	var tagIDs []string
+	for _, tag := range b {
+		tagIDs = append(tagIDs, tag.tagID)
+	}
+	return tagIDs
+}
+
+// validate はタグIDが有効で重複していないか調べる
+func (b BookTags) validate() error {
+	seen := map[string]struct{}{}
+	for _, tag := range b {
+		if !ulid.IsValid(tag.tagID) {
+			return errDomain.NewMessageError(errDomain.CodeInvalid, "tagIDs", "tag.id.invalid")
+		}
+		if _, ok := seen[tag.tagID]; ok {
+			return errDomain.NewMessageError(errDomain.CodeDuplicate, "tagIDs", "book.tag.duplicate")
+		}
+		seen[tag.tagID] = struct{}{}
+	}
+	return nil
+}
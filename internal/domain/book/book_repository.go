@@ -0,0 +1,49 @@
+package book
+
+import (
+	"context"
+	"time"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/authorrole"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/isbn"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+)
+
+// ErrDuplicateISBN は同じISBNの書籍が既に登録されている場合に Save が返す
+var ErrDuplicateISBN = errDomain.NewMessageError(errDomain.CodeDuplicate, "isbn", "book.isbn.duplicate")
+
+// ErrDuplicateAltID は同じ代替識別子の書籍が既に登録されている場合に Save が返す
+var ErrDuplicateAltID = errDomain.NewMessageError(errDomain.CodeDuplicate, "altID", "book.alt_id.duplicate")
+
+// ErrInSeries はシリーズに含まれている書籍を物理削除しようとした場合に Delete が返す
+// シリーズは1冊以上の書籍を持つため、先にシリーズから外す必要がある
+var ErrInSeries = errDomain.NewMessageError(errDomain.CodeConflict, "", "book.in_series")
+
+type BookRepository interface {
+	Save(ctx context.Context, book *Book) error
+	FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*Book, error)
+	FindByISBN(ctx context.Context, code isbn.ISBN, opts ...repository.FindOption) (*Book, error)
+	// FindByAltID はISBNのない書籍を頒布イベント、サークル、品番で探す
+	FindByAltID(ctx context.Context, altID AltID, opts ...repository.FindOption) (*Book, error)
+	FindAll(ctx context.Context, opts ...repository.FindOption) ([]*Book, error)
+	// FindAllOrderByReading はタイトルの読みの五十音順に返す。読みのない書籍はタイトルで比べる
+	FindAllOrderByReading(ctx context.Context, opts ...repository.FindOption) ([]*Book, error)
+	FindBySizeID(ctx context.Context, sizeID string, opts ...repository.FindOption) ([]*Book, error)
+	FindByTagIDs(ctx context.Context, tagIDs []string, opts ...repository.FindOption) ([]*Book, error)
+	// FindByAuthorID は役割によらず著者がクレジットされている書籍を返す
+	FindByAuthorID(ctx context.Context, authorID string, opts ...repository.FindOption) ([]*Book, error)
+	// FindByAuthorRole は著者が role でクレジットされている書籍を返す(この人がイラストを描いた書籍など)
+	FindByAuthorRole(ctx context.Context, authorID string, role authorrole.Role, opts ...repository.FindOption) ([]*Book, error)
+	// FindByISBNPrefix はハイフンなしのISBN-13が prefix で始まる書籍を返す
+	FindByISBNPrefix(ctx context.Context, prefix string, opts ...repository.FindOption) ([]*Book, error)
+	// Delete は行を物理削除し、著者リスト・タグリストからも外す。シリーズに含まれている場合は ErrInSeries を返す
+	// 論理削除はエンティティのDeleteの後にSaveする
+	Delete(ctx context.Context, id string) error
+	// Purge は before より前に論理削除された行を物理削除し、削除した件数を返す
+	// シリーズに含まれている書籍は、唯一の巻を消すとシリーズが読み込めなくなるため残す
+	// 残した書籍はシリーズから外すまで削除されないので、件数を CountUnpurged で知らせる
+	Purge(ctx context.Context, before time.Time) (int64, error)
+	// CountUnpurged は before より前に論理削除されたが、シリーズに含まれているため Purge で残る書籍の件数を返す
+	CountUnpurged(ctx context.Context, before time.Time) (int64, error)
+}
@@ -1,31 +1,46 @@
 package book
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/authorrole"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/bookjan"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	isbnDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/isbn"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
 	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
 )
 
-func TestNewBook(t *testing.T) {
-	validISBN := "9784758079211"
-	invalidISBN := "9784758079212"
+func Test_newBook(t *testing.T) {
+	validISBN := mustISBN(t, "9784758079211")
+	altID, err := NewAltID("コミックマーケット105", "サークル", "新刊A")
+	if err != nil {
+		t.Fatal(err)
+	}
 	labelID := ulid.NewULID()
 	publishID := ulid.NewULID()
+	sizeID := ulid.NewULID()
 	authorID1 := ulid.NewULID()
 	authorID2 := ulid.NewULID()
+	tagID := ulid.NewULID()
 	now := time.Now()
 	earlier := now.Add(-1 * time.Hour)
 	later := now.Add(1 * time.Hour)
 	type args struct {
-		isbn         *string
+		isbn         *isbnDomain.ISBN
+		altID        *AltID
 		labelID      string
 		publishID    string
+		sizeID       string
 		title        string
+		titlePhonic  string
 		authorIDs    BookAuthors
+		tagIDs       BookTags
 		releaseDay   time.Time
 		price        int
 		explain      string
@@ -46,13 +61,16 @@ func TestNewBook(t *testing.T) {
 				isbn:      &validISBN,
 				labelID:   labelID,
 				publishID: publishID,
+				sizeID:    sizeID,
 				title:     "書籍タイトル",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 					{
 						authorID: authorID2,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   now,
@@ -66,13 +84,16 @@ func TestNewBook(t *testing.T) {
 				isbn:      &validISBN,
 				labelID:   labelID,
 				publishID: publishID,
+				sizeID:    sizeID,
 				title:     "書籍タイトル",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 					{
 						authorID: authorID2,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   now,
@@ -91,13 +112,16 @@ func TestNewBook(t *testing.T) {
 				isbn:      nil,
 				labelID:   labelID,
 				publishID: publishID,
+				sizeID:    sizeID,
 				title:     "書籍タイトル",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 					{
 						authorID: authorID2,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   now,
@@ -111,13 +135,16 @@ func TestNewBook(t *testing.T) {
 				isbn:      nil,
 				labelID:   labelID,
 				publishID: publishID,
+				sizeID:    sizeID,
 				title:     "書籍タイトル",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 					{
 						authorID: authorID2,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   now,
@@ -131,15 +158,18 @@ func TestNewBook(t *testing.T) {
 			wantErrStr: "",
 		},
 		{
-			name: "異常系: ISBNが不正",
+			name: "異常系: ISBNと代替識別子を同時に設定",
 			args: args{
-				isbn:      &invalidISBN,
+				isbn:      &validISBN,
+				altID:     &altID,
 				labelID:   labelID,
 				publishID: publishID,
+				sizeID:    sizeID,
 				title:     "書籍タイトル",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   now,
@@ -151,7 +181,7 @@ func TestNewBook(t *testing.T) {
 			},
 			want:       nil,
 			wantErr:    true,
-			wantErrStr: "ISBNが不正です",
+			wantErrStr: "ISBNと代替識別子は同時に設定できません",
 		},
 		{
 			name: "異常系: レーベルIDが不正",
@@ -159,10 +189,12 @@ func TestNewBook(t *testing.T) {
 				isbn:      &validISBN,
 				labelID:   "labelID",
 				publishID: publishID,
+				sizeID:    sizeID,
 				title:     "書籍タイトル",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   now,
@@ -182,10 +214,12 @@ func TestNewBook(t *testing.T) {
 				isbn:      &validISBN,
 				labelID:   labelID,
 				publishID: "publishID",
+				sizeID:    sizeID,
 				title:     "書籍タイトル",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   now,
@@ -199,16 +233,43 @@ func TestNewBook(t *testing.T) {
 			wantErr:    true,
 			wantErrStr: "出版社IDが不正です",
 		},
+		{
+			name: "異常系: 判型IDが不正",
+			args: args{
+				isbn:      &validISBN,
+				labelID:   labelID,
+				publishID: publishID,
+				sizeID:    "sizeID",
+				title:     "書籍タイトル",
+				authorIDs: []BookAuthor{
+					{
+						authorID: authorID1,
+						role:     authorrole.Writer,
+					},
+				},
+				releaseDay:   now,
+				price:        800,
+				explain:      "書籍の説明",
+				createAt:     now,
+				lastUpdateAt: later,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "判型IDが不正です",
+		},
 		{
 			name: "異常系: タイトルが不正",
 			args: args{
 				isbn:      &validISBN,
 				labelID:   labelID,
 				publishID: publishID,
+				sizeID:    sizeID,
 				title:     "",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   now,
@@ -228,6 +289,7 @@ func TestNewBook(t *testing.T) {
 				isbn:         &validISBN,
 				labelID:      labelID,
 				publishID:    publishID,
+				sizeID:       sizeID,
 				title:        "書籍タイトル",
 				authorIDs:    []BookAuthor{},
 				releaseDay:   now,
@@ -241,16 +303,106 @@ func TestNewBook(t *testing.T) {
 			wantErr:    true,
 			wantErrStr: fmt.Sprintf("著者は%d人以上である必要があります", bookAuthorsLengthMin),
 		},
+		{
+			name: "異常系: 著者IDが不正",
+			args: args{
+				isbn:      &validISBN,
+				labelID:   labelID,
+				publishID: publishID,
+				sizeID:    sizeID,
+				title:     "書籍タイトル",
+				authorIDs: []BookAuthor{
+					{
+						authorID: "authorID",
+						role:     authorrole.Writer,
+					},
+				},
+				releaseDay:   now,
+				price:        800,
+				explain:      "書籍の説明",
+				createAt:     now,
+				lastUpdateAt: later,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "著者IDが不正です",
+		},
+		{
+			name: "異常系: タグIDが不正",
+			args: args{
+				isbn:      &validISBN,
+				labelID:   labelID,
+				publishID: publishID,
+				sizeID:    sizeID,
+				title:     "書籍タイトル",
+				authorIDs: []BookAuthor{
+					{
+						authorID: authorID1,
+						role:     authorrole.Writer,
+					},
+				},
+				tagIDs: []BookTag{
+					{
+						tagID: "tagID",
+					},
+				},
+				releaseDay:   now,
+				price:        800,
+				explain:      "書籍の説明",
+				createAt:     now,
+				lastUpdateAt: later,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "タグIDが不正です",
+		},
+		{
+			name: "異常系: タグが重複",
+			args: args{
+				isbn:      &validISBN,
+				labelID:   labelID,
+				publishID: publishID,
+				sizeID:    sizeID,
+				title:     "書籍タイトル",
+				authorIDs: []BookAuthor{
+					{
+						authorID: authorID1,
+						role:     authorrole.Writer,
+					},
+				},
+				tagIDs: []BookTag{
+					{
+						tagID: tagID,
+					},
+					{
+						tagID: tagID,
+					},
+				},
+				releaseDay:   now,
+				price:        800,
+				explain:      "書籍の説明",
+				createAt:     now,
+				lastUpdateAt: later,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "タグが重複しています",
+		},
 		{
 			name: "異常系: 発売日が不正",
 			args: args{
 				isbn:      &validISBN,
 				labelID:   labelID,
 				publishID: publishID,
+				sizeID:    sizeID,
 				title:     "書籍タイトル",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   time.Time{},
@@ -270,10 +422,12 @@ func TestNewBook(t *testing.T) {
 				isbn:      &validISBN,
 				labelID:   labelID,
 				publishID: publishID,
+				sizeID:    sizeID,
 				title:     "書籍タイトル",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   now,
@@ -293,10 +447,12 @@ func TestNewBook(t *testing.T) {
 				isbn:      &validISBN,
 				labelID:   labelID,
 				publishID: publishID,
+				sizeID:    sizeID,
 				title:     "書籍タイトル",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   now,
@@ -316,10 +472,12 @@ func TestNewBook(t *testing.T) {
 				isbn:      &validISBN,
 				labelID:   labelID,
 				publishID: publishID,
+				sizeID:    sizeID,
 				title:     "書籍タイトル",
 				authorIDs: []BookAuthor{
 					{
 						authorID: authorID1,
+						role:     authorrole.Writer,
 					},
 				},
 				releaseDay:   now,
@@ -336,21 +494,27 @@ func TestNewBook(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewBook(
+			got, err := newBook(
+				ulid.NewULID(),
 				tt.args.isbn,
+				tt.args.altID,
 				tt.args.labelID,
 				tt.args.publishID,
+				tt.args.sizeID,
 				tt.args.title,
+				tt.args.titlePhonic,
 				tt.args.authorIDs,
+				tt.args.tagIDs,
 				tt.args.releaseDay,
 				tt.args.price,
+				nil,
 				tt.args.explain,
 				tt.args.createAt,
 				tt.args.lastUpdateAt,
 				tt.args.deletedAt,
 			)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NewBook() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("newBook() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			if err != nil && err.Error() != tt.wantErrStr {
 				if diff := cmp.Diff(err.Error(), tt.wantErrStr); diff != "" {
@@ -359,12 +523,612 @@ func TestNewBook(t *testing.T) {
 			}
 			diff := cmp.Diff(
 				got, tt.want,
-				cmp.AllowUnexported(Book{}, BookAuthor{}),
+				cmp.AllowUnexported(Book{}, BookAuthor{}, BookTag{}, AltID{}, isbnDomain.ISBN{}),
 				cmpopts.IgnoreFields(Book{}, "id"),
 			)
 
 			if diff != "" {
-				t.Errorf("NewBook() = %v, want = %v.\n error is %s", got, tt.want, diff)
+				t.Errorf("newBook() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestNewBook(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	got, err := NewBook(clk, nil, nil, ulid.NewULID(), ulid.NewULID(), ulid.NewULID(), "書籍タイトル", "ショセキタイトル", []BookAuthor{{authorID: ulid.NewULID(), role: authorrole.Writer}}, nil, now, 800, nil, "書籍の説明")
+	if err != nil {
+		t.Fatalf("NewBook() error = %v", err)
+	}
+	if !ulid.IsValid(got.ID()) {
+		t.Errorf("ID() = %s, want ULID", got.ID())
+	}
+	if !got.CreateAt().Equal(now) || !got.LastUpdateAt().Equal(now) {
+		t.Errorf("CreateAt() = %v, LastUpdateAt() = %v, want = %v", got.CreateAt(), got.LastUpdateAt(), now)
+	}
+	if got.IsDeleted() {
+		t.Errorf("IsDeleted() = true, want = false")
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		isbn       *string
+		wantISBN   string
+		wantOk     bool
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:     "正常系",
+			isbn:     ptr("9784758079211"),
+			wantISBN: "9784758079211",
+			wantOk:   true,
+			wantErr:  false,
+		},
+		{
+			name:     "正常系: ISBN-10をISBN-13に正規化",
+			isbn:     ptr("4-7580-7921-8"),
+			wantISBN: "9784758079211",
+			wantOk:   true,
+			wantErr:  false,
+		},
+		{
+			name:    "正常系: ISBNがnil",
+			isbn:    nil,
+			wantOk:  false,
+			wantErr: false,
+		},
+		{
+			name:       "異常系: ISBNが不正",
+			isbn:       ptr("9784758079212"),
+			wantErr:    true,
+			wantErrStr: "ISBNが不正です",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Reconstruct(
+				ulid.NewULID(), tt.isbn, nil, ulid.NewULID(), ulid.NewULID(), ulid.NewULID(), "書籍タイトル", "",
+				[]BookAuthor{{authorID: ulid.NewULID(), role: authorrole.Writer}}, nil, now, 800, nil, "書籍の説明", now, now, nil,
+			)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Reconstruct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if err.Error() != tt.wantErrStr {
+					t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+				}
+				return
+			}
+			code, ok := got.ISBN()
+			if ok != tt.wantOk || (ok && code.String() != tt.wantISBN) {
+				t.Errorf("ISBN() = (%v, %v), want = (%v, %v)", code, ok, tt.wantISBN, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestNewBook_ValidationError(t *testing.T) {
+	now := time.Now()
+	_, err := NewBook(
+		clock.NewFixed(now), ptr("9784758079212"), nil, ulid.NewULID(), "不正なID", ulid.NewULID(), "", "",
+		[]BookAuthor{{authorID: ulid.NewULID(), role: authorrole.Writer}}, nil, now, -1, nil, "書籍の説明",
+	)
+	var verr *errDomain.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("NewBook() error = %v, want ValidationError", err)
+	}
+	var got []string
+	for _, e := range verr.Errors() {
+		got = append(got, string(e.Code())+":"+e.Field())
+	}
+	want := []string{"invalid:isbn", "invalid:publishID", "too_short:title", "out_of_range:price"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Errors() = %v, want = %v.\n error is %s", got, want, diff)
+	}
+	if !errors.Is(err, errDomain.NewFieldError(errDomain.CodeTooShort, "title", "")) {
+		t.Errorf("errors.Is() = false, want = true")
+	}
+}
+
+func TestNewAltID(t *testing.T) {
+	tests := []struct {
+		name       string
+		event      string
+		circle     string
+		item       string
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:    "正常系",
+			event:   "コミックマーケット105",
+			circle:  "サークル",
+			item:    "新刊A",
+			wantErr: false,
+		},
+		{
+			name:       "異常系: イベント名が不正",
+			event:      "",
+			circle:     "サークル",
+			item:       "新刊A",
+			wantErr:    true,
+			wantErrStr: fmt.Sprintf("頒布イベント名は%d文字以上である必要があります", eventLengthMin),
+		},
+		{
+			name:       "異常系: サークル名が不正",
+			event:      "コミックマーケット105",
+			circle:     "",
+			item:       "新刊A",
+			wantErr:    true,
+			wantErrStr: fmt.Sprintf("サークル名は%d文字以上である必要があります", circleLengthMin),
+		},
+		{
+			name:       "異常系: 品番が不正",
+			event:      "コミックマーケット105",
+			circle:     "サークル",
+			item:       "",
+			wantErr:    true,
+			wantErrStr: fmt.Sprintf("頒布物の品番は%d文字以上である必要があります", itemLengthMin),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAltID(tt.event, tt.circle, tt.item)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewAltID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if err == nil && (got.Event() != tt.event || got.Circle() != tt.circle || got.Item() != tt.item) {
+				t.Errorf("NewAltID() = %v, want = %s/%s/%s", got, tt.event, tt.circle, tt.item)
+			}
+		})
+	}
+}
+
+func TestBook_AttachTag(t *testing.T) {
+	tagID1 := ulid.NewULID()
+	tagID2 := ulid.NewULID()
+	tests := []struct {
+		name       string
+		tagIDs     BookTags
+		attach     string
+		want       []string
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:    "正常系",
+			tagIDs:  BookTags{{tagID: tagID1}},
+			attach:  tagID2,
+			want:    []string{tagID1, tagID2},
+			wantErr: false,
+		},
+		{
+			name:       "異常系: タグIDが不正",
+			tagIDs:     BookTags{{tagID: tagID1}},
+			attach:     "tagID",
+			want:       []string{tagID1},
+			wantErr:    true,
+			wantErrStr: "タグIDが不正です",
+		},
+		{
+			name:       "異常系: 付与済みのタグ",
+			tagIDs:     BookTags{{tagID: tagID1}},
+			attach:     tagID1,
+			want:       []string{tagID1},
+			wantErr:    true,
+			wantErrStr: "タグが重複しています",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBook(t)
+			b.tagIDs = tt.tagIDs
+			err := b.AttachTag(clock.NewFixed(time.Now()), tt.attach)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AttachTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if diff := cmp.Diff(b.TagIDs(), tt.want); diff != "" {
+				t.Errorf("TagIDs() = %v, want = %v.\n error is %s", b.TagIDs(), tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestBook_DetachTag(t *testing.T) {
+	tagID1 := ulid.NewULID()
+	tagID2 := ulid.NewULID()
+	tests := []struct {
+		name       string
+		tagIDs     BookTags
+		detach     string
+		want       []string
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:    "正常系",
+			tagIDs:  BookTags{{tagID: tagID1}, {tagID: tagID2}},
+			detach:  tagID1,
+			want:    []string{tagID2},
+			wantErr: false,
+		},
+		{
+			name:       "異常系: 付与されていないタグ",
+			tagIDs:     BookTags{{tagID: tagID1}},
+			detach:     tagID2,
+			want:       []string{tagID1},
+			wantErr:    true,
+			wantErrStr: "タグが付与されていません",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBook(t)
+			b.tagIDs = tt.tagIDs
+			err := b.DetachTag(clock.NewFixed(time.Now()), tt.detach)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DetachTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if diff := cmp.Diff(b.TagIDs(), tt.want); diff != "" {
+				t.Errorf("TagIDs() = %v, want = %v.\n error is %s", b.TagIDs(), tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestBook_ChangeAuthors(t *testing.T) {
+	// 渡したスライスを後から書き換えても、バリデーションを通らずに書籍が変わらないようにする
+	b := newTestBook(t)
+	authors := []BookAuthor{NewBookAuthor(ulid.NewULID(), authorrole.Writer)}
+	if err := b.ChangeAuthors(clock.NewFixed(time.Now()), authors); err != nil {
+		t.Fatalf("ChangeAuthors() error = %v", err)
+	}
+	want := b.Authors()
+	authors[0] = NewBookAuthor(ulid.NewULID(), authorrole.Illustrator)
+	if diff := cmp.Diff(b.Authors(), want, cmp.AllowUnexported(BookAuthor{})); diff != "" {
+		t.Errorf("Authors() = %v, want = %v.\n error is %s", b.Authors(), want, diff)
+	}
+}
+
+func TestBook_ChangeTags(t *testing.T) {
+	tagID1 := ulid.NewULID()
+	tagID2 := ulid.NewULID()
+	tests := []struct {
+		name       string
+		tagIDs     BookTags
+		change     []string
+		want       []string
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:    "正常系",
+			tagIDs:  BookTags{{tagID: tagID1}},
+			change:  []string{tagID1, tagID2},
+			want:    []string{tagID1, tagID2},
+			wantErr: false,
+		},
+		{
+			name:    "正常系: すべて外す",
+			tagIDs:  BookTags{{tagID: tagID1}},
+			change:  nil,
+			want:    []string{},
+			wantErr: false,
+		},
+		{
+			name:       "異常系: タグが重複",
+			tagIDs:     BookTags{{tagID: tagID1}},
+			change:     []string{tagID2, tagID2},
+			want:       []string{tagID1},
+			wantErr:    true,
+			wantErrStr: "タグが重複しています",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBook(t)
+			b.tagIDs = tt.tagIDs
+			err := b.ChangeTags(clock.NewFixed(time.Now()), tt.change)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ChangeTags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if diff := cmp.Diff(b.TagIDs(), tt.want, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("TagIDs() = %v, want = %v.\n error is %s", b.TagIDs(), tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestBook_ChangeTitle(t *testing.T) {
+	now := time.Now()
+	later := now.Add(1 * time.Hour)
+	tests := []struct {
+		name             string
+		title            string
+		titlePhonic      string
+		wantTitle        string
+		wantTitlePhonic  string
+		wantLastUpdateAt time.Time
+		wantErr          bool
+		wantErrStr       string
+	}{
+		{
+			name:             "正常系",
+			title:            "新しいタイトル",
+			titlePhonic:      "あたらしいタイトル",
+			wantTitle:        "新しいタイトル",
+			wantTitlePhonic:  "アタラシイタイトル",
+			wantLastUpdateAt: later,
+			wantErr:          false,
+		},
+		{
+			name:             "異常系: 読みが不正",
+			title:            "新しいタイトル",
+			titlePhonic:      "新しいタイトル",
+			wantTitle:        "書籍タイトル",
+			wantLastUpdateAt: now,
+			wantErr:          true,
+			wantErrStr:       "タイトルの読みはカタカナである必要があります",
+		},
+		{
+			name:             "異常系: タイトルが不正",
+			title:            "",
+			wantTitle:        "書籍タイトル",
+			wantLastUpdateAt: now,
+			wantErr:          true,
+			wantErrStr:       fmt.Sprintf("タイトル名は%d文字以上である必要があります", titleLengthMin),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBook(t)
+			b.createAt, b.lastUpdateAt = now, now
+			err := b.ChangeTitle(clock.NewFixed(later), tt.title, tt.titlePhonic)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ChangeTitle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if b.Title() != tt.wantTitle || b.TitlePhonic() != tt.wantTitlePhonic {
+				t.Errorf("Title() = (%v, %v), want = (%v, %v)", b.Title(), b.TitlePhonic(), tt.wantTitle, tt.wantTitlePhonic)
+			}
+			if !b.LastUpdateAt().Equal(tt.wantLastUpdateAt) {
+				t.Errorf("LastUpdateAt() = %v, want = %v", b.LastUpdateAt(), tt.wantLastUpdateAt)
+			}
+		})
+	}
+}
+
+func TestBook_ChangePrice(t *testing.T) {
+	now := time.Now()
+	later := now.Add(1 * time.Hour)
+	tests := []struct {
+		name       string
+		price      int
+		wantPrice  int
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:      "正常系",
+			price:     1200,
+			wantPrice: 1200,
+			wantErr:   false,
+		},
+		{
+			name:       "異常系: 金額が不正",
+			price:      -1,
+			wantPrice:  800,
+			wantErr:    true,
+			wantErrStr: fmt.Sprintf("金額は%d円以上である必要があります", priceMin),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBook(t)
+			b.createAt, b.lastUpdateAt = now, now
+			err := b.ChangePrice(clock.NewFixed(later), tt.price)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ChangePrice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if b.Price() != tt.wantPrice {
+				t.Errorf("Price() = %v, want = %v", b.Price(), tt.wantPrice)
+			}
+		})
+	}
+}
+
+func TestBook_ApplyPriceCode(t *testing.T) {
+	now := time.Now()
+	later := now.Add(1 * time.Hour)
+	code, err := bookjan.ParsePriceCode("1920079006804")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := newTestBook(t)
+	if _, ok := b.CCode(); ok {
+		t.Fatalf("CCode() ok = true, want = false")
+	}
+	if err := b.ApplyPriceCode(clock.NewFixed(later), code); err != nil {
+		t.Fatalf("ApplyPriceCode() error = %v", err)
+	}
+	if cCode, ok := b.CCode(); !ok || cCode.String() != "C0079" {
+		t.Errorf("CCode() = (%v, %v), want = (C0079, true)", cCode, ok)
+	}
+	if b.Price() != 680 {
+		t.Errorf("Price() = %v, want = 680", b.Price())
+	}
+	if !b.LastUpdateAt().Equal(later) {
+		t.Errorf("LastUpdateAt() = %v, want = %v", b.LastUpdateAt(), later)
+	}
+}
+
+func newTestBook(t *testing.T) *Book {
+	t.Helper()
+	now := time.Now()
+	b, err := NewBook(
+		clock.NewFixed(now),
+		nil,
+		nil,
+		ulid.NewULID(),
+		ulid.NewULID(),
+		ulid.NewULID(),
+		"書籍タイトル",
+		"",
+		[]BookAuthor{{authorID: ulid.NewULID(), role: authorrole.Writer}},
+		nil,
+		now,
+		800,
+		nil,
+		"書籍の説明",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestBook_DeleteRestore(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	v := newTestBook(t)
+	v.createAt, v.lastUpdateAt = now, now
+
+	if err := v.Restore(clk); err == nil || err.Error() != "削除されていません" {
+		t.Errorf("Restore() error = %v, want 削除されていません", err)
+	}
+
+	clk.Advance(1 * time.Hour)
+	if err := v.Delete(clk); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !v.IsDeleted() || !v.DeletedAt().Equal(clk.Now()) || !v.LastUpdateAt().Equal(clk.Now()) {
+		t.Errorf("DeletedAt() = %v, LastUpdateAt() = %v, want = %v", v.DeletedAt(), v.LastUpdateAt(), clk.Now())
+	}
+	if err := v.Delete(clk); err == nil || err.Error() != "既に削除されています" {
+		t.Errorf("Delete() error = %v, want 既に削除されています", err)
+	}
+
+	clk.Advance(1 * time.Hour)
+	if err := v.Restore(clk); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if v.IsDeleted() || !v.LastUpdateAt().Equal(clk.Now()) {
+		t.Errorf("DeletedAt() = %v, LastUpdateAt() = %v, want = nil, %v", v.DeletedAt(), v.LastUpdateAt(), clk.Now())
+	}
+}
+
+// tickingClock は呼び出すたびに1秒進む時計
+type tickingClock struct {
+	now time.Time
+}
+
+func (c *tickingClock) Now() time.Time {
+	c.now = c.now.Add(time.Second)
+	return c.now
+}
+
+func TestBook_Delete_SameTime(t *testing.T) {
+	v := newTestBook(t)
+	if err := v.Delete(&tickingClock{now: time.Now()}); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !v.DeletedAt().Equal(v.LastUpdateAt()) {
+		t.Errorf("DeletedAt() = %v, LastUpdateAt() = %v, want same time", v.DeletedAt(), v.LastUpdateAt())
+	}
+}
+
+func mustISBN(t *testing.T, s string) isbnDomain.ISBN {
+	t.Helper()
+	code, err := isbnDomain.NewISBN(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func TestBook_Authors(t *testing.T) {
+	now := time.Now()
+	originalID := ulid.NewULID()
+	artistID := ulid.NewULID()
+	tests := []struct {
+		name             string
+		authors          []BookAuthor
+		wantAuthorIDs    []string
+		wantIllustrators []string
+		wantErrStr       string
+	}{
+		{
+			name:          "正常系: 原作と作画",
+			authors:       []BookAuthor{NewBookAuthor(originalID, authorrole.Original), NewBookAuthor(artistID, authorrole.Artist)},
+			wantAuthorIDs: []string{originalID, artistID},
+		},
+		{
+			name:             "正常系: 同じ著者が複数の役割でクレジットされる",
+			authors:          []BookAuthor{NewBookAuthor(originalID, authorrole.Writer), NewBookAuthor(artistID, authorrole.Illustrator), NewBookAuthor(originalID, authorrole.Illustrator)},
+			wantAuthorIDs:    []string{originalID, artistID},
+			wantIllustrators: []string{artistID, originalID},
+		},
+		{
+			name:       "異常系: 役割が不正",
+			authors:    []BookAuthor{NewBookAuthor(originalID, "監修")},
+			wantErrStr: "著者の役割が不正です",
+		},
+		{
+			name:       "異常系: 同じ著者が同じ役割で重複",
+			authors:    []BookAuthor{NewBookAuthor(originalID, authorrole.Writer), NewBookAuthor(originalID, authorrole.Writer)},
+			wantErrStr: "同じ著者が同じ役割で重複しています",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := NewBook(
+				clock.NewFixed(now), nil, nil, ulid.NewULID(), ulid.NewULID(), ulid.NewULID(), "書籍タイトル", "",
+				tt.authors, nil, now, 800, nil, "書籍の説明",
+			)
+			if tt.wantErrStr != "" {
+				if err == nil || err.Error() != tt.wantErrStr {
+					t.Errorf("NewBook() error = %v, wantErrStr %s", err, tt.wantErrStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewBook() error = %v", err)
+			}
+			if diff := cmp.Diff(b.Authors(), tt.authors, cmp.AllowUnexported(BookAuthor{})); diff != "" {
+				t.Errorf("Authors() = %v, want = %v.\n error is %s", b.Authors(), tt.authors, diff)
+			}
+			if diff := cmp.Diff(b.AuthorIDs(), tt.wantAuthorIDs); diff != "" {
+				t.Errorf("AuthorIDs() = %v, want = %v.\n error is %s", b.AuthorIDs(), tt.wantAuthorIDs, diff)
+			}
+			if diff := cmp.Diff(b.AuthorIDsByRole(authorrole.Illustrator), tt.wantIllustrators); diff != "" {
+				t.Errorf("AuthorIDsByRole() = %v, want = %v.\n error is %s", b.AuthorIDsByRole(authorrole.Illustrator), tt.wantIllustrators, diff)
 			}
 		})
 	}
@@ -0,0 +1,194 @@
+package bookjan
+
+import "testing"
+
+func TestNewCCode(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		want       string
+		wantErr    bool
+		wantErrStr string
+	}{
+		{name: "正常系", s: "C0079", want: "C0079", wantErr: false},
+		{name: "正常系: Cなし", s: "0093", want: "C0093", wantErr: false},
+		{name: "正常系: 小文字", s: "c9979", want: "C9979", wantErr: false},
+		{name: "異常系: 桁数が不正", s: "C079", wantErr: true, wantErrStr: "Cコードが不正です"},
+		{name: "異常系: 数字以外", s: "C00A9", wantErr: true, wantErrStr: "Cコードが不正です"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCCode(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCCode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("NewCCode() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCCode_Parts(t *testing.T) {
+	c, err := NewCCode("C0979")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := c.Audience(); !ok || got.String() != "一般" {
+		t.Errorf("Audience() = %v, %v, want = 一般, true", got, ok)
+	}
+	if got, ok := c.Format(); !ok || got.String() != "コミック" {
+		t.Errorf("Format() = %v, %v, want = コミック, true", got, ok)
+	}
+	if got, ok := c.Subject(); !ok || got != "79" {
+		t.Errorf("Subject() = %v, %v, want = 79, true", got, ok)
+	}
+	if got, ok := c.SubjectGroup(); !ok || got != "芸術・生活" {
+		t.Errorf("SubjectGroup() = %v, %v, want = 芸術・生活, true", got, ok)
+	}
+}
+
+func TestAudience_String(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Audience
+		want string
+	}{
+		{name: "正常系", a: 0, want: "一般"},
+		{name: "正常系: 最後の値", a: 9, want: "雑誌扱い"},
+		{name: "異常系: 範囲外", a: 10, want: "10"},
+		{name: "異常系: 負の値", a: -1, want: "-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.String(); got != tt.want {
+				t.Errorf("String() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat_String(t *testing.T) {
+	tests := []struct {
+		name string
+		f    Format
+		want string
+	}{
+		{name: "正常系", f: 0, want: "単行本"},
+		{name: "正常系: 最後の値", f: 9, want: "コミック"},
+		{name: "異常系: 範囲外", f: 10, want: "10"},
+		{name: "異常系: 負の値", f: -1, want: "-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.String(); got != tt.want {
+				t.Errorf("String() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCCode_Zero(t *testing.T) {
+	var c CCode
+	if !c.IsZero() {
+		t.Errorf("IsZero() = false, want = true")
+	}
+	if _, ok := c.Audience(); ok {
+		t.Errorf("Audience() ok = true, want = false")
+	}
+	if _, ok := c.Format(); ok {
+		t.Errorf("Format() ok = true, want = false")
+	}
+	if _, ok := c.Subject(); ok {
+		t.Errorf("Subject() ok = true, want = false")
+	}
+	if _, ok := c.SubjectGroup(); ok {
+		t.Errorf("SubjectGroup() ok = true, want = false")
+	}
+}
+
+func TestParsePriceCode(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		wantCCode  string
+		wantPrice  int
+		wantErr    bool
+		wantErrStr string
+	}{
+		{name: "正常系", s: "1920079006804", wantCCode: "C0079", wantPrice: 680, wantErr: false},
+		{name: "正常系: ハイフン付き", s: "192-0093-00800-6", wantCCode: "C0093", wantPrice: 800, wantErr: false},
+		{name: "異常系: チェックディジットが不正", s: "1920079006805", wantErr: true, wantErrStr: "書籍JANコードが不正です"},
+		{name: "異常系: 192で始まらない", s: "9784758079211", wantErr: true, wantErrStr: "書籍JANコードが不正です"},
+		{name: "異常系: 桁数が不正", s: "192007900680", wantErr: true, wantErrStr: "書籍JANコードが不正です"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePriceCode(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePriceCode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if err == nil && (got.CCode().String() != tt.wantCCode || got.Price() != tt.wantPrice) {
+				t.Errorf("ParsePriceCode() = (%v, %v), want = (%v, %v)", got.CCode(), got.Price(), tt.wantCCode, tt.wantPrice)
+			}
+		})
+	}
+}
+
+func TestNewScan(t *testing.T) {
+	tests := []struct {
+		name          string
+		codes         []string
+		wantISBN      string
+		wantPriceCode string
+		wantErr       bool
+		wantErrStr    string
+	}{
+		{
+			name:          "正常系",
+			codes:         []string{"1920079006804", "9784758079211"},
+			wantISBN:      "9784758079211",
+			wantPriceCode: "C0079",
+			wantErr:       false,
+		},
+		{
+			name:     "正常系: 2段目がない",
+			codes:    []string{"9784758079211"},
+			wantISBN: "9784758079211",
+			wantErr:  false,
+		},
+		{
+			name:       "異常系: ISBNがない",
+			codes:      []string{"1920079006804", "4901234567894"},
+			wantErr:    true,
+			wantErrStr: "ISBNのバーコードが見つかりません",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewScan(tt.codes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewScan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if err != nil {
+				return
+			}
+			if got.ISBN().String() != tt.wantISBN {
+				t.Errorf("ISBN() = %v, want = %v", got.ISBN(), tt.wantISBN)
+			}
+			p, ok := got.PriceCode()
+			if ok != (tt.wantPriceCode != "") || (ok && p.CCode().String() != tt.wantPriceCode) {
+				t.Errorf("PriceCode() = (%v, %v), want = %v", p.CCode(), ok, tt.wantPriceCode)
+			}
+		})
+	}
+}
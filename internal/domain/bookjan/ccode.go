@@ -0,0 +1,142 @@
+package bookjan
+
+import (
+	"strconv"
+	"strings"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+)
+
+const cCodeLength = 4
+
+// Audience は分類コード1桁目の販売対象
+type Audience int
+
+var audienceNames = [...]string{
+	"一般",
+	"教養",
+	"実用",
+	"専門",
+	"検定教科書・その他",
+	"婦人",
+	"学参I(小中学生対象)",
+	"学参II(高校生対象)",
+	"児童",
+	"雑誌扱い",
+}
+
+// String は販売対象の名前を返す。範囲外の値は数値のまま返す
+func (a Audience) String() string {
+	if a < 0 || int(a) >= len(audienceNames) {
+		return strconv.Itoa(int(a))
+	}
+	return audienceNames[a]
+}
+
+// Format は分類コード2桁目の発行形態
+type Format int
+
+var formatNames = [...]string{
+	"単行本",
+	"文庫",
+	"新書",
+	"全集・双書",
+	"ムック・その他",
+	"事・辞典",
+	"図鑑",
+	"絵本",
+	"磁性媒体など",
+	"コミック",
+}
+
+// String は発行形態の名前を返す。範囲外の値は数値のまま返す
+func (f Format) String() string {
+	if f < 0 || int(f) >= len(formatNames) {
+		return strconv.Itoa(int(f))
+	}
+	return formatNames[f]
+}
+
+// subjectGroupNames は分類コード3桁目(内容の大分類)の名前
+var subjectGroupNames = [...]string{
+	"総記",
+	"哲学・心理学・宗教",
+	"歴史・地理",
+	"社会科学",
+	"自然科学",
+	"工学・工業",
+	"産業",
+	"芸術・生活",
+	"語学",
+	"文学",
+}
+
+// CCode は書籍JANコードの2段目に入る分類コード(Cコード)
+type CCode struct {
+	code string
+}
+
+// NewCCode は C0079 と 0079 のどちらの形式も受け付ける
+func NewCCode(s string) (CCode, error) {
+	code := strings.TrimPrefix(strings.ToUpper(s), "C")
+	if len(code) != cCodeLength || !isDigits(code) {
+		return CCode{}, errDomain.NewMessageError(errDomain.CodeInvalid, "cCode", "book.c_code.invalid")
+	}
+	return CCode{code: code}, nil
+}
+
+// String は C0079 の形式で返す
+func (c CCode) String() string {
+	return "C" + c.code
+}
+
+// Digits は先頭のCを除いた4桁を返す
+func (c CCode) Digits() string {
+	return c.code
+}
+
+// IsZero は NewCCode を通さずに作った空の分類コードか
+func (c CCode) IsZero() bool {
+	return len(c.code) != cCodeLength
+}
+
+// Audience は販売対象を返す。空の分類コードの場合は false
+func (c CCode) Audience() (Audience, bool) {
+	if c.IsZero() {
+		return 0, false
+	}
+	return Audience(c.code[0] - '0'), true
+}
+
+// Format は発行形態を返す。空の分類コードの場合は false
+func (c CCode) Format() (Format, bool) {
+	if c.IsZero() {
+		return 0, false
+	}
+	return Format(c.code[1] - '0'), true
+}
+
+// Subject は内容を表す2桁を返す。空の分類コードの場合は false
+func (c CCode) Subject() (string, bool) {
+	if c.IsZero() {
+		return "", false
+	}
+	return c.code[2:], true
+}
+
+// SubjectGroup は内容の大分類の名前を返す。空の分類コードの場合は false
+func (c CCode) SubjectGroup() (string, bool) {
+	if c.IsZero() {
+		return "", false
+	}
+	return subjectGroupNames[c.code[2]-'0'], true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
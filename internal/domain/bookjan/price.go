@@ -0,0 +1,52 @@
+package bookjan
+
+import (
+	"strconv"
+	"strings"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/checkdigit"
+)
+
+const (
+	priceCodeLength = 13
+	// 書籍JANコード2段目のフラグ
+	priceCodePrefix = "192"
+)
+
+// PriceCode は書籍JANコードの2段目
+// 192 + Cコード4桁 + 本体価格5桁 + チェックディジット で構成される
+type PriceCode struct {
+	cCode CCode
+	price int
+}
+
+// ParsePriceCode は2段目のバーコードを読み取る。ハイフンと空白は読み飛ばす
+func ParsePriceCode(s string) (PriceCode, error) {
+	code := strings.NewReplacer("-", "", " ", "").Replace(s)
+	if len(code) != priceCodeLength || !isDigits(code) || !strings.HasPrefix(code, priceCodePrefix) {
+		return PriceCode{}, errDomain.NewMessageError(errDomain.CodeInvalid, "priceCode", "book.price_code.invalid")
+	}
+	if !checkdigit.JAN13IsValid(code) {
+		return PriceCode{}, errDomain.NewMessageError(errDomain.CodeInvalid, "priceCode", "book.price_code.invalid")
+	}
+
+	cCode, err := NewCCode(code[3:7])
+	if err != nil {
+		return PriceCode{}, err
+	}
+	price, err := strconv.Atoi(code[7:12])
+	if err != nil {
+		return PriceCode{}, errDomain.NewMessageError(errDomain.CodeInvalid, "priceCode", "book.price_code.invalid")
+	}
+	return PriceCode{cCode: cCode, price: price}, nil
+}
+
+func (p PriceCode) CCode() CCode {
+	return p.cCode
+}
+
+// Price は税抜の本体価格を返す
+func (p PriceCode) Price() int {
+	return p.price
+}
@@ -0,0 +1,54 @@
+package bookjan
+
+import (
+	"strings"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/isbn"
+)
+
+// Scan は裏表紙のバーコードの読み取り結果
+// 1段目のISBNは必須で、2段目の価格バーコードは写っていない場合がある
+type Scan struct {
+	isbn      isbn.ISBN
+	priceCode *PriceCode
+}
+
+// NewScan は読み取った13桁のコードからISBNと2段目を振り分ける
+// 先に渡されたコードを優先する
+func NewScan(codes []string) (Scan, error) {
+	var scan Scan
+	found := false
+	for _, code := range codes {
+		switch {
+		case strings.HasPrefix(code, priceCodePrefix):
+			if scan.priceCode != nil {
+				continue
+			}
+			if p, err := ParsePriceCode(code); err == nil {
+				scan.priceCode = &p
+			}
+		case !found:
+			if i, err := isbn.NewISBN(code); err == nil {
+				scan.isbn = i
+				found = true
+			}
+		}
+	}
+	if !found {
+		return Scan{}, errDomain.NewMessageError(errDomain.CodeInvalid, "isbn", "book.isbn.barcode_not_found")
+	}
+	return scan, nil
+}
+
+func (s Scan) ISBN() isbn.ISBN {
+	return s.isbn
+}
+
+// PriceCode は2段目を読み取れなかった場合は false を返す
+func (s Scan) PriceCode() (PriceCode, bool) {
+	if s.priceCode == nil {
+		return PriceCode{}, false
+	}
+	return *s.priceCode, true
+}
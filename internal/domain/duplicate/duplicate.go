@@ -0,0 +1,206 @@
+package duplicate
+
+import (
+	"slices"
+	"time"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+// Kind は重複を探す対象
+type Kind string
+
+const (
+	KindBook    Kind = "book"
+	KindAuthor  Kind = "author"
+	KindLabel   Kind = "label"
+	KindPublish Kind = "publish"
+)
+
+var Kinds = []Kind{KindBook, KindAuthor, KindLabel, KindPublish}
+
+func (k Kind) IsValid() bool {
+	return slices.Contains(Kinds, k)
+}
+
+// Reason は重複とみなした理由
+type Reason string
+
+const (
+	// ReasonSameTitle は表記揺れを揃えたタイトルが同じで、著者が共通する書籍
+	ReasonSameTitle Reason = "same_title"
+	// ReasonSimilarTitle はタイトルがほぼ同じで、著者が共通する書籍
+	ReasonSimilarTitle Reason = "similar_title"
+	// ReasonSameName は表記揺れを揃えた名前が同じ
+	ReasonSameName Reason = "same_name"
+	// ReasonSameNamePhonic は読みが同じ
+	ReasonSameNamePhonic Reason = "same_name_phonic"
+)
+
+// scores は理由ごとの点数。理由が複数あれば合計し、scoreMax で打ち切る
+var scores = map[Reason]int{
+	ReasonSameTitle:      90,
+	ReasonSimilarTitle:   70,
+	ReasonSameName:       80,
+	ReasonSameNamePhonic: 40,
+}
+
+const scoreMax = 100
+
+// Status は候補の対応状況
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusMerged    Status = "merged"
+	StatusDismissed Status = "dismissed"
+)
+
+// Candidate は重複の候補
+// 後から登録された duplicate を先に登録された canonical に統合する想定で並べる
+type Candidate struct {
+	id          string
+	kind        Kind
+	duplicateID string
+	canonicalID string
+	score       int
+	reasons     []Reason
+	status      Status
+	detectedAt  time.Time
+	resolvedAt  *time.Time
+}
+
+func newCandidate(
+	id string,
+	kind Kind,
+	duplicateID string,
+	canonicalID string,
+	reasons []Reason,
+	status Status,
+	detectedAt time.Time,
+	resolvedAt *time.Time,
+) (*Candidate, error) {
+	if !ulid.IsValid(id) {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "id", "duplicate.id.invalid")
+	}
+	if !kind.IsValid() {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "kind", "duplicate.kind.invalid")
+	}
+	if !ulid.IsValid(duplicateID) || !ulid.IsValid(canonicalID) || duplicateID == canonicalID {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "duplicateID", "duplicate.pair.invalid")
+	}
+	if len(reasons) == 0 {
+		return nil, errDomain.NewMessageError(errDomain.CodeTooShort, "reasons", "duplicate.reasons.too_short", 1)
+	}
+	score := 0
+	for _, reason := range reasons {
+		s, ok := scores[reason]
+		if !ok {
+			return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "reasons", "duplicate.reason.invalid")
+		}
+		score += s
+	}
+	if status != StatusPending && status != StatusMerged && status != StatusDismissed {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "status", "duplicate.status.invalid")
+	}
+	if resolvedAt != nil && resolvedAt.Before(detectedAt) {
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "resolvedAt", "duplicate.resolved_at.before_detected")
+	}
+
+	return &Candidate{
+		id:          id,
+		kind:        kind,
+		duplicateID: duplicateID,
+		canonicalID: canonicalID,
+		score:       min(score, scoreMax),
+		reasons:     reasons,
+		status:      status,
+		detectedAt:  detectedAt,
+		resolvedAt:  resolvedAt,
+	}, nil
+}
+
+func Reconstruct(
+	id string,
+	kind Kind,
+	duplicateID string,
+	canonicalID string,
+	reasons []Reason,
+	status Status,
+	detectedAt time.Time,
+	resolvedAt *time.Time,
+) (*Candidate, error) {
+	return newCandidate(id, kind, duplicateID, canonicalID, reasons, status, detectedAt, resolvedAt)
+}
+
+func NewCandidate(
+	clk clock.Clock,
+	kind Kind,
+	duplicateID string,
+	canonicalID string,
+	reasons []Reason,
+) (*Candidate, error) {
+	return newCandidate(ulid.NewULID(), kind, duplicateID, canonicalID, reasons, StatusPending, clk.Now(), nil)
+}
+
+func (c *Candidate) ID() string {
+	return c.id
+}
+
+func (c *Candidate) Kind() Kind {
+	return c.kind
+}
+
+func (c *Candidate) DuplicateID() string {
+	return c.duplicateID
+}
+
+func (c *Candidate) CanonicalID() string {
+	return c.canonicalID
+}
+
+// Score は0から100の点数で、高いほど重複している可能性が高い
+func (c *Candidate) Score() int {
+	return c.score
+}
+
+func (c *Candidate) Reasons() []Reason {
+	return slices.Clone(c.reasons)
+}
+
+func (c *Candidate) Status() Status {
+	return c.status
+}
+
+func (c *Candidate) DetectedAt() time.Time {
+	return c.detectedAt
+}
+
+func (c *Candidate) ResolvedAt() *time.Time {
+	return c.resolvedAt
+}
+
+// MarkMerged は統合済みにする
+func (c *Candidate) MarkMerged(clk clock.Clock) error {
+	return c.resolve(clk, StatusMerged)
+}
+
+// Dismiss は重複ではないとして候補から外す。次回の検出でも候補に戻さない
+func (c *Candidate) Dismiss(clk clock.Clock) error {
+	return c.resolve(clk, StatusDismissed)
+}
+
+func (c *Candidate) resolve(clk clock.Clock, status Status) error {
+	if c.status != StatusPending {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "status", "duplicate.already_resolved")
+	}
+	now := clk.Now()
+	updated, err := newCandidate(c.id, c.kind, c.duplicateID, c.canonicalID, c.reasons, status, c.detectedAt, &now)
+	if err != nil {
+		return err
+	}
+	*c = *updated
+	return nil
+}
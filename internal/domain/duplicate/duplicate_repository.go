@@ -0,0 +1,23 @@
+package duplicate
+
+import (
+	"context"
+
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+)
+
+type CandidateRepository interface {
+	Save(ctx context.Context, candidate *Candidate) error
+	FindByID(ctx context.Context, id string) (*Candidate, error)
+	// FindPending は未対応の候補を点数の高い順に返す
+	FindPending(ctx context.Context) ([]*Candidate, error)
+	// ReplacePending は kind の未対応の候補を candidates で置き換える
+	// 統合済みの組や候補から外した組は再び追加しない
+	ReplacePending(ctx context.Context, kind Kind, candidates []*Candidate) error
+}
+
+// Merger は候補の duplicate を canonical に統合し、候補を統合済みにする
+// 統合と候補の更新は1つのトランザクションで行う
+type Merger interface {
+	Merge(ctx context.Context, clk clock.Clock, candidate *Candidate) error
+}
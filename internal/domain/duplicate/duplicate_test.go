@@ -0,0 +1,95 @@
+package duplicate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+func TestNewCandidate(t *testing.T) {
+	duplicateID := ulid.NewULID()
+	tests := []struct {
+		name        string
+		kind        Kind
+		canonicalID string
+		reasons     []Reason
+		wantScore   int
+		wantErr     bool
+		wantErrStr  string
+	}{
+		{
+			name:        "正常系",
+			kind:        KindAuthor,
+			canonicalID: ulid.NewULID(),
+			reasons:     []Reason{ReasonSameNamePhonic},
+			wantScore:   40,
+		},
+		{
+			name:        "正常系: 点数は100で打ち切る",
+			kind:        KindAuthor,
+			canonicalID: ulid.NewULID(),
+			reasons:     []Reason{ReasonSameName, ReasonSameNamePhonic},
+			wantScore:   100,
+		},
+		{
+			name:        "異常系: 種類が不正",
+			kind:        "series",
+			canonicalID: ulid.NewULID(),
+			reasons:     []Reason{ReasonSameName},
+			wantErr:     true,
+			wantErrStr:  "重複候補の種類が不正です",
+		},
+		{
+			name:        "異常系: 同じIDの組",
+			kind:        KindAuthor,
+			canonicalID: duplicateID,
+			reasons:     []Reason{ReasonSameName},
+			wantErr:     true,
+			wantErrStr:  "重複候補の組が不正です",
+		},
+		{
+			name:        "異常系: 理由がない",
+			kind:        KindAuthor,
+			canonicalID: ulid.NewULID(),
+			reasons:     nil,
+			wantErr:     true,
+			wantErrStr:  "重複候補の理由は1件以上である必要があります",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCandidate(clock.NewFixed(time.Now()), tt.kind, duplicateID, tt.canonicalID, tt.reasons)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCandidate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if err == nil && (got.Score() != tt.wantScore || got.Status() != StatusPending) {
+				t.Errorf("Score() = %d, Status() = %s, want = %d, %s", got.Score(), got.Status(), tt.wantScore, StatusPending)
+			}
+		})
+	}
+}
+
+func TestCandidate_Resolve(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	c, err := NewCandidate(clk, KindLabel, ulid.NewULID(), ulid.NewULID(), []Reason{ReasonSameName})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clk.Advance(1 * time.Hour)
+	if err := c.Dismiss(clk); err != nil {
+		t.Fatalf("Dismiss() error = %v", err)
+	}
+	if c.Status() != StatusDismissed || c.ResolvedAt() == nil || !c.ResolvedAt().Equal(clk.Now()) {
+		t.Errorf("Status() = %s, ResolvedAt() = %v, want = %s, %v", c.Status(), c.ResolvedAt(), StatusDismissed, clk.Now())
+	}
+	if err := c.MarkMerged(clk); err == nil || err.Error() != "重複候補は既に対応済みです" {
+		t.Errorf("MarkMerged() error = %v, want 重複候補は既に対応済みです", err)
+	}
+}
@@ -0,0 +1,197 @@
+package duplicate
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/book"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/text"
+)
+
+// similarTitleMin はタイトルがほぼ同じとみなす類似度の下限
+const similarTitleMin = 0.8
+
+// digits はタイトルの巻数などの数字を取り出す
+var digits = regexp.MustCompile(`[0-9]+`)
+
+// Named は名前と読みを持つエンティティ
+type Named interface {
+	ID() string
+	Name() string
+	NamePhonic() string
+	CreateAt() time.Time
+}
+
+// aliased は別名の本人を持つエンティティ。別名と本人は重複とみなさない
+type aliased interface {
+	CanonicalID() *string
+}
+
+// entry は重複を比べる対象の1件
+type entry struct {
+	id       string
+	createAt time.Time
+}
+
+// pairs は重複の組と理由を集める
+type pairs struct {
+	kind    Kind
+	reasons map[[2]string][]Reason
+	entries map[string]entry
+}
+
+func newPairs(kind Kind) *pairs {
+	return &pairs{kind: kind, reasons: map[[2]string][]Reason{}, entries: map[string]entry{}}
+}
+
+func (p *pairs) add(a, b entry, reason Reason) {
+	if a.id == b.id {
+		return
+	}
+	// 先に登録されたほうを統合先にする
+	if b.createAt.Before(a.createAt) || (b.createAt.Equal(a.createAt) && b.id < a.id) {
+		a, b = b, a
+	}
+	key := [2]string{b.id, a.id}
+	if !slices.Contains(p.reasons[key], reason) {
+		p.reasons[key] = append(p.reasons[key], reason)
+	}
+}
+
+// candidates は点数の高い順に候補を返す
+func (p *pairs) candidates(clk clock.Clock) []*Candidate {
+	var candidates []*Candidate
+	for key, reasons := range p.reasons {
+		c, err := NewCandidate(clk, p.kind, key[0], key[1], reasons)
+		if err != nil {
+			// 比べる対象は検証済みのエンティティなので起こらない
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+	slices.SortFunc(candidates, func(a, b *Candidate) int {
+		return cmp.Or(
+			-cmp.Compare(a.score, b.score),
+			cmp.Compare(a.canonicalID, b.canonicalID),
+			cmp.Compare(a.duplicateID, b.duplicateID),
+		)
+	})
+	return candidates
+}
+
+// groups は key が同じものの組をすべて reason で追加する
+func (p *pairs) groups(entries []entry, keys []string, reason Reason) {
+	byKey := map[string][]entry{}
+	for i, e := range entries {
+		if keys[i] != "" {
+			byKey[keys[i]] = append(byKey[keys[i]], e)
+		}
+	}
+	for _, group := range byKey {
+		for i := range group {
+			for j := i + 1; j < len(group); j++ {
+				p.add(group[i], group[j], reason)
+			}
+		}
+	}
+}
+
+// FindNamed は名前か読みの表記揺れを揃えると同じになる組を探す
+// 全角と半角、空白や中黒の有無、旧字体と新字体の違いを無視する
+func FindNamed[T Named](clk clock.Clock, kind Kind, items []T) []*Candidate {
+	p := newPairs(kind)
+	entries := make([]entry, 0, len(items))
+	names := make([]string, 0, len(items))
+	phonics := make([]string, 0, len(items))
+	for _, item := range items {
+		entries = append(entries, entry{id: item.ID(), createAt: item.CreateAt()})
+		names = append(names, text.NormalizeName(item.Name()))
+		phonics = append(phonics, text.NormalizeName(text.NormalizeReading(item.NamePhonic())))
+	}
+	p.groups(entries, names, ReasonSameName)
+	p.groups(entries, phonics, ReasonSameNamePhonic)
+
+	// 別名として登録済みの組は除く
+	for _, item := range items {
+		a, ok := any(item).(aliased)
+		if !ok || a.CanonicalID() == nil {
+			continue
+		}
+		for key := range p.reasons {
+			if (key[0] == item.ID() && key[1] == *a.CanonicalID()) || (key[1] == item.ID() && key[0] == *a.CanonicalID()) {
+				delete(p.reasons, key)
+			}
+		}
+	}
+	return p.candidates(clk)
+}
+
+// FindBooks は著者が共通してタイトルが同じかほぼ同じ書籍の組を探す
+// タイトルに含まれる数字が異なるものは別の巻とみなして除く
+// ISBNは論理削除した行も含めて一意で、同じISBNの書籍は保存時に弾かれるため比べない
+func FindBooks(clk clock.Clock, books []*book.Book) []*Candidate {
+	p := newPairs(KindBook)
+	entries := make([]entry, 0, len(books))
+	for _, b := range books {
+		entries = append(entries, entry{id: b.ID(), createAt: b.CreateAt()})
+	}
+
+	// タイトルは著者が共通する書籍どうしだけを比べる
+	byAuthor := map[string][]int{}
+	titles := make([][]rune, 0, len(books))
+	for i, b := range books {
+		titles = append(titles, []rune(text.NormalizeName(b.Title())))
+		for _, authorID := range b.AuthorIDs() {
+			byAuthor[authorID] = append(byAuthor[authorID], i)
+		}
+	}
+	for _, group := range byAuthor {
+		for x := range group {
+			for y := x + 1; y < len(group); y++ {
+				i, j := group[x], group[y]
+				if !slices.Equal(digits.FindAllString(string(titles[i]), -1), digits.FindAllString(string(titles[j]), -1)) {
+					continue
+				}
+				switch {
+				case slices.Equal(titles[i], titles[j]):
+					p.add(entries[i], entries[j], ReasonSameTitle)
+				case similarity(titles[i], titles[j]) >= similarTitleMin:
+					p.add(entries[i], entries[j], ReasonSimilarTitle)
+				}
+			}
+		}
+	}
+	return p.candidates(clk)
+}
+
+// similarity は編集距離から0から1の類似度を求める
+func similarity(a, b []rune) float64 {
+	longest := max(len(a), len(b))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(a, b))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
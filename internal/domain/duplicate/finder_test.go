@@ -0,0 +1,179 @@
+package duplicate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/author"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/authorrole"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/book"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+// pair は候補を比べやすくするために名前と理由にしたもの
+type pair struct {
+	Duplicate string
+	Canonical string
+	Score     int
+	Reasons   []Reason
+}
+
+func TestFindNamed(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		authors [][2]string
+		want    []pair
+	}{
+		{
+			name:    "正常系: 旧字体と空白の違い",
+			authors: [][2]string{{"澤村 伊智", "サワムラ イチ"}, {"沢村伊智", "さわむら いち"}},
+			want:    []pair{{Duplicate: "沢村伊智", Canonical: "澤村 伊智", Score: 100, Reasons: []Reason{ReasonSameName, ReasonSameNamePhonic}}},
+		},
+		{
+			name:    "正常系: 全角と半角の違い",
+			authors: [][2]string{{"ＣＬＡＭＰ", "クランプ"}, {"clamp", "ｸﾗﾝﾌﾟ"}},
+			want:    []pair{{Duplicate: "clamp", Canonical: "ＣＬＡＭＰ", Score: 100, Reasons: []Reason{ReasonSameName, ReasonSameNamePhonic}}},
+		},
+		{
+			name:    "正常系: 読みだけが同じ",
+			authors: [][2]string{{"夏目漱石", "ナツメ ソウセキ"}, {"なつめそうせき", "ナツメ・ソウセキ"}, {"芥川龍之介", "アクタガワ リュウノスケ"}},
+			want:    []pair{{Duplicate: "なつめそうせき", Canonical: "夏目漱石", Score: 40, Reasons: []Reason{ReasonSameNamePhonic}}},
+		},
+		{
+			name:    "正常系: 重複なし",
+			authors: [][2]string{{"夏目漱石", "ナツメ ソウセキ"}, {"芥川龍之介", "アクタガワ リュウノスケ"}},
+			want:    []pair{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := clock.NewFixed(now)
+			var authors []*author.Author
+			names := map[string]string{}
+			for _, name := range tt.authors {
+				a, err := author.NewAuthor(clk, name[0], name[1])
+				if err != nil {
+					t.Fatal(err)
+				}
+				authors = append(authors, a)
+				names[a.ID()] = a.Name()
+				clk.Advance(1 * time.Minute)
+			}
+
+			got := []pair{}
+			for _, c := range FindNamed(clk, KindAuthor, authors) {
+				got = append(got, pair{Duplicate: names[c.DuplicateID()], Canonical: names[c.CanonicalID()], Score: c.Score(), Reasons: c.Reasons()})
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("FindNamed() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestFindNamed_Alias(t *testing.T) {
+	clk := clock.NewFixed(time.Now())
+	canonical, err := author.NewAuthor(clk, "尾田栄一郎", "オダ エイイチロウ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	alias, err := author.NewAuthor(clk, "尾田 栄一郎", "オダ エイイチロウ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := alias.SetCanonical(clk, canonical); err != nil {
+		t.Fatal(err)
+	}
+	if got := FindNamed(clk, KindAuthor, []*author.Author{canonical, alias}); len(got) != 0 {
+		t.Errorf("FindNamed() = %v, want = []", got)
+	}
+}
+
+func TestFindBooks(t *testing.T) {
+	now := time.Now()
+	authorID := ulid.NewULID()
+	otherAuthorID := ulid.NewULID()
+	tests := []struct {
+		name  string
+		books []struct {
+			title    string
+			authorID string
+		}
+		want []pair
+	}{
+		{
+			name: "正常系: タイトルの表記揺れ",
+			books: []struct {
+				title    string
+				authorID string
+			}{
+				{title: "ワンピース　１", authorID: authorID},
+				{title: "ﾜﾝﾋﾟｰｽ 1", authorID: authorID},
+			},
+			want: []pair{{Duplicate: "ﾜﾝﾋﾟｰｽ 1", Canonical: "ワンピース　１", Score: 90, Reasons: []Reason{ReasonSameTitle}}},
+		},
+		{
+			name: "正常系: タイトルがほぼ同じ",
+			books: []struct {
+				title    string
+				authorID string
+			}{
+				{title: "吾輩は猫である", authorID: authorID},
+				{title: "我輩は猫である", authorID: authorID},
+			},
+			want: []pair{{Duplicate: "我輩は猫である", Canonical: "吾輩は猫である", Score: 70, Reasons: []Reason{ReasonSimilarTitle}}},
+		},
+		{
+			name: "正常系: 巻数が異なるものは除く",
+			books: []struct {
+				title    string
+				authorID string
+			}{
+				{title: "ワンピース 1", authorID: authorID},
+				{title: "ワンピース 2", authorID: authorID},
+			},
+			want: []pair{},
+		},
+		{
+			name: "正常系: 著者が異なるものは除く",
+			books: []struct {
+				title    string
+				authorID string
+			}{
+				{title: "こころ", authorID: authorID},
+				{title: "こころ", authorID: otherAuthorID},
+			},
+			want: []pair{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := clock.NewFixed(now)
+			var books []*book.Book
+			titles := map[string]string{}
+			for _, b := range tt.books {
+				got, err := book.NewBook(
+					clk, nil, nil, ulid.NewULID(), ulid.NewULID(), ulid.NewULID(), b.title, "",
+					[]book.BookAuthor{book.NewBookAuthor(b.authorID, authorrole.Writer)}, nil, now, 800, nil, "",
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+				books = append(books, got)
+				titles[got.ID()] = got.Title()
+				clk.Advance(1 * time.Minute)
+			}
+
+			got := []pair{}
+			for _, c := range FindBooks(clk, books) {
+				got = append(got, pair{Duplicate: titles[c.DuplicateID()], Canonical: titles[c.CanonicalID()], Score: c.Score(), Reasons: c.Reasons()})
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("FindBooks() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package error
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"strings"
+)
+
+// Lang はエラーメッセージの言語
+type Lang string
+
+const (
+	LangJa Lang = "ja"
+	LangEn Lang = "en"
+	// DefaultLang は Error が返すメッセージの言語
+	DefaultLang = LangJa
+)
+
+// Langs は対応している言語
+var Langs = []Lang{LangJa, LangEn}
+
+//go:embed messages/*.tsv
+var messageFiles embed.FS
+
+var catalogs = mustParseCatalogs()
+
+// parseCatalog はキーとメッセージをタブで区切った行を読み込む
+// 空行と # で始まる行は無視する
+func parseCatalog(s string) (map[string]string, error) {
+	catalog := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, message, ok := strings.Cut(line, "\t")
+		if !ok || key == "" || message == "" {
+			return nil, fmt.Errorf("%d: invalid line", n)
+		}
+		if _, ok := catalog[key]; ok {
+			return nil, fmt.Errorf("%d: duplicate key %s", n, key)
+		}
+		catalog[key] = message
+	}
+	return catalog, scanner.Err()
+}
+
+func mustParseCatalogs() map[Lang]map[string]string {
+	catalogs := map[Lang]map[string]string{}
+	for _, lang := range Langs {
+		b, err := messageFiles.ReadFile("messages/" + string(lang) + ".tsv")
+		if err != nil {
+			panic(err)
+		}
+		catalog, err := parseCatalog(string(b))
+		if err != nil {
+			panic(fmt.Sprintf("messages/%s.tsv:%v", lang, err))
+		}
+		catalogs[lang] = catalog
+	}
+	return catalogs
+}
+
+// Message はカタログからメッセージを引いて引数を埋め込む
+// 指定した言語にキーがなければ日本語を使い、日本語にもなければキーをそのまま返す
+func Message(lang Lang, key string, args ...any) string {
+	format, ok := catalogs[lang][key]
+	if !ok {
+		format, ok = catalogs[DefaultLang][key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// ParseLang は言語タグを対応している言語に変換する
+// "en-US" のような地域付きのタグは言語部分だけを見る
+func ParseLang(tag string) (Lang, bool) {
+	base, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	for _, lang := range Langs {
+		if string(lang) == base {
+			return lang, true
+		}
+	}
+	return "", false
+}
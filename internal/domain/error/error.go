@@ -1,15 +1,175 @@
 package error
 
+import (
+	"errors"
+	"strings"
+)
+
+// Code はエラーの種類を表す
+// APIのクライアントが日本語のメッセージを比較せずにエラーを判別するために使う
+type Code string
+
+const (
+	CodeUnknown Code = "unknown"
+	// CodeInvalid は値の形式が不正であることを表す
+	CodeInvalid Code = "invalid"
+	// CodeTooShort は文字数や件数が足りないことを表す
+	CodeTooShort Code = "too_short"
+	// CodeOutOfRange は数値や日付が範囲外であることを表す
+	CodeOutOfRange Code = "out_of_range"
+	// CodeDuplicate は値が重複していることを表す
+	CodeDuplicate Code = "duplicate"
+	// CodeNotFound は対象が存在しないことを表す
+	CodeNotFound Code = "not_found"
+	// CodeConflict は現在の状態では実行できない操作であることを表す
+	CodeConflict Code = "conflict"
+)
+
 type Error struct {
+	code        Code
+	field       string
 	description string
+	// key と args はメッセージカタログから他の言語のメッセージを引くために使う
+	key  string
+	args []any
 }
 
 func (e *Error) Error() string {
 	return e.description
 }
 
+func (e *Error) Code() Code {
+	return e.code
+}
+
+// Localize は指定した言語のメッセージを返す
+// カタログを使わずに作ったエラーは言語によらず同じメッセージを返す
+func (e *Error) Localize(lang Lang) string {
+	if e.key == "" {
+		return e.description
+	}
+	return Message(lang, e.key, e.args...)
+}
+
+// Field は原因となった項目名を返す
+// 特定の項目に紐づかないエラーの場合は空文字を返す
+func (e *Error) Field() string {
+	return e.field
+}
+
+// Is はコードと項目名が一致するエラーを同じエラーとみなす
+// target の項目名が空の場合はコードのみを比較する
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok || t.code == CodeUnknown {
+		return false
+	}
+	return t.code == e.code && (t.field == "" || t.field == e.field)
+}
+
 func NewError(s string) *Error {
 	return &Error{
+		code:        CodeUnknown,
+		description: s,
+	}
+}
+
+// NewFieldError はコードと項目名を持つエラーを作る
+func NewFieldError(code Code, field string, s string) *Error {
+	return &Error{
+		code:        code,
+		field:       field,
 		description: s,
 	}
 }
+
+// NewMessageError はメッセージカタログのキーからエラーを作る
+// Error は日本語のメッセージを返し、他の言語は Localize で取り出す
+func NewMessageError(code Code, field string, key string, args ...any) *Error {
+	return &Error{
+		code:        code,
+		field:       field,
+		description: Message(DefaultLang, key, args...),
+		key:         key,
+		args:        args,
+	}
+}
+
+var ErrNotFound = NewMessageError(CodeNotFound, "", "not_found")
+
+// ValidationError は複数のバリデーションエラーをまとめる
+// ゼロ値のまま Add で追加していき、最後に Err で取り出す
+type ValidationError struct {
+	errs []*Error
+}
+
+// Error はすべてのエラーメッセージを改行でつなげる
+// エラーが1件の場合はそのエラーと同じ文字列になる
+func (v *ValidationError) Error() string {
+	messages := make([]string, 0, len(v.errs))
+	for _, err := range v.errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (v *ValidationError) Localize(lang Lang) string {
+	messages := make([]string, 0, len(v.errs))
+	for _, err := range v.errs {
+		messages = append(messages, err.Localize(lang))
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (v *ValidationError) Errors() []*Error {
+	return v.errs
+}
+
+func (v *ValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(v.errs))
+	for _, err := range v.errs {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// Add はエラーを追加する
+// nil は無視し、ValidationError はまとめられたエラーをそれぞれ追加する
+func (v *ValidationError) Add(err error) {
+	if err == nil {
+		return
+	}
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		v.errs = append(v.errs, verr.errs...)
+		return
+	}
+	var derr *Error
+	if errors.As(err, &derr) {
+		v.errs = append(v.errs, derr)
+		return
+	}
+	v.errs = append(v.errs, NewError(err.Error()))
+}
+
+// Err はエラーが1件もなければ nil を返す
+func (v *ValidationError) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v
+}
+
+// Localize は err に含まれるドメインエラーを指定した言語のメッセージにする
+// ドメインエラーを含まない場合は err.Error() をそのまま返す
+func Localize(err error, lang Lang) string {
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		return verr.Localize(lang)
+	}
+	var derr *Error
+	if errors.As(err, &derr) {
+		return derr.Localize(lang)
+	}
+	return err.Error()
+}
@@ -0,0 +1,132 @@
+package error
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestError_Is(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{
+			name:   "正常系: コードと項目名が一致",
+			err:    NewFieldError(CodeDuplicate, "isbn", "ISBNが既に登録されています"),
+			target: NewFieldError(CodeDuplicate, "isbn", ""),
+			want:   true,
+		},
+		{
+			name:   "正常系: 項目名のない対象はコードのみ比較",
+			err:    NewFieldError(CodeNotFound, "id", "対象が見つかりません"),
+			target: ErrNotFound,
+			want:   true,
+		},
+		{
+			name:   "正常系: ラップされたエラー",
+			err:    fmt.Errorf("書籍の保存: %w", NewFieldError(CodeInvalid, "title", "")),
+			target: NewFieldError(CodeInvalid, "title", ""),
+			want:   true,
+		},
+		{
+			name:   "異常系: 項目名が異なる",
+			err:    NewFieldError(CodeInvalid, "title", ""),
+			target: NewFieldError(CodeInvalid, "isbn", ""),
+			want:   false,
+		},
+		{
+			name:   "異常系: コードが異なる",
+			err:    NewFieldError(CodeInvalid, "isbn", ""),
+			target: NewFieldError(CodeDuplicate, "isbn", ""),
+			want:   false,
+		},
+		{
+			name:   "異常系: コードのないエラー同士",
+			err:    NewError("エラー"),
+			target: NewError("エラー"),
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	var verr ValidationError
+	if err := verr.Err(); err != nil {
+		t.Fatalf("Err() = %v, want nil", err)
+	}
+
+	verr.Add(nil)
+	verr.Add(NewFieldError(CodeInvalid, "isbn", "ISBNが不正です"))
+	if got := verr.Err().Error(); got != "ISBNが不正です" {
+		t.Errorf("Error() = %v, want = ISBNが不正です", got)
+	}
+
+	var inner ValidationError
+	inner.Add(NewFieldError(CodeTooShort, "title", "タイトル名は1文字以上である必要があります"))
+	verr.Add(inner.Err())
+	err := verr.Err()
+	if got, want := err.Error(), "ISBNが不正です\nタイトル名は1文字以上である必要があります"; got != want {
+		t.Errorf("Error() = %v, want = %v", got, want)
+	}
+	if !errors.Is(err, NewFieldError(CodeTooShort, "title", "")) {
+		t.Errorf("errors.Is() = false, want = true")
+	}
+
+	var got *ValidationError
+	if !errors.As(err, &got) {
+		t.Fatalf("errors.As() = false, want = true")
+	}
+	var fields []string
+	for _, e := range got.Errors() {
+		fields = append(fields, e.Field())
+	}
+	if fmt.Sprint(fields) != "[isbn title]" {
+		t.Errorf("Field() = %v, want = [isbn title]", fields)
+	}
+}
+
+func TestError_Localize(t *testing.T) {
+	err := NewMessageError(CodeTooShort, "title", "book.title.too_short", 1)
+	if got, want := err.Error(), "タイトル名は1文字以上である必要があります"; got != want {
+		t.Errorf("Error() = %v, want = %v", got, want)
+	}
+	if got, want := err.Localize(LangEn), "Title must be at least 1 characters"; got != want {
+		t.Errorf("Localize() = %v, want = %v", got, want)
+	}
+	if got, want := NewFieldError(CodeInvalid, "isbn", "ISBNが不正です").Localize(LangEn), "ISBNが不正です"; got != want {
+		t.Errorf("Localize() = %v, want = %v", got, want)
+	}
+}
+
+// TestCatalogs は日本語と英語のカタログに同じキーと同じ書式の引数があるか調べる
+func TestCatalogs(t *testing.T) {
+	verbs := regexp.MustCompile(`%[a-z]`)
+	for key, ja := range catalogs[LangJa] {
+		en, ok := catalogs[LangEn][key]
+		if !ok {
+			t.Errorf("en: %s がありません", key)
+			continue
+		}
+		if diff := cmp.Diff(verbs.FindAllString(en, -1), verbs.FindAllString(ja, -1)); diff != "" {
+			t.Errorf("en: %s の引数が一致しません.\n error is %s", key, diff)
+		}
+	}
+	for key := range catalogs[LangEn] {
+		if _, ok := catalogs[LangJa][key]; !ok {
+			t.Errorf("ja: %s がありません", key)
+		}
+	}
+}
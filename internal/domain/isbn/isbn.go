@@ -0,0 +1,71 @@
+package isbn
+
+import (
+	"strings"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/checkdigit"
+)
+
+const (
+	isbn10Length = 10
+	isbn13Length = 13
+	// ISBN-10 から変換したISBN-13に付く接頭辞
+	bookland = "978"
+)
+
+// ISBN はハイフンを除いたISBN-13で保持する
+type ISBN struct {
+	code string
+}
+
+// NewISBN はISBN-10とISBN-13のどちらも受け付け、ISBN-13に正規化する
+// ハイフンと空白は読み飛ばす
+func NewISBN(s string) (ISBN, error) {
+	code := strings.ToUpper(strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, s))
+
+	switch len(code) {
+	case isbn10Length:
+		if !checkdigit.ISBN10IsValid(code) {
+			return ISBN{}, errDomain.NewMessageError(errDomain.CodeInvalid, "isbn", "book.isbn.invalid")
+		}
+		seed := bookland + code[:isbn10Length-1]
+		d, err := checkdigit.ISBN13CheckDigit(seed)
+		if err != nil {
+			return ISBN{}, errDomain.NewMessageError(errDomain.CodeInvalid, "isbn", "book.isbn.invalid")
+		}
+		return ISBN{code: seed + string(d)}, nil
+	case isbn13Length:
+		if !strings.HasPrefix(code, "978") && !strings.HasPrefix(code, "979") {
+			return ISBN{}, errDomain.NewMessageError(errDomain.CodeInvalid, "isbn", "book.isbn.invalid")
+		}
+		if !checkdigit.ISBN13IsValid(code) {
+			return ISBN{}, errDomain.NewMessageError(errDomain.CodeInvalid, "isbn", "book.isbn.invalid")
+		}
+		return ISBN{code: code}, nil
+	}
+	return ISBN{}, errDomain.NewMessageError(errDomain.CodeInvalid, "isbn", "book.isbn.invalid")
+}
+
+// String はハイフンなしのISBN-13を返す
+func (i ISBN) String() string {
+	return i.code
+}
+
+// ISBN10 は978で始まるISBNのみISBN-10に変換できる
+func (i ISBN) ISBN10() (string, bool) {
+	if !strings.HasPrefix(i.code, bookland) {
+		return "", false
+	}
+	seed := i.code[len(bookland) : isbn13Length-1]
+	d, err := checkdigit.ISBN10CheckDigit(seed)
+	if err != nil {
+		return "", false
+	}
+	return seed + string(d), true
+}
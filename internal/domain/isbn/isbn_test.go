@@ -0,0 +1,197 @@
+package isbn
+
+import "testing"
+
+func TestNewISBN(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		want       string
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:    "正常系: ISBN-13",
+			s:       "9784758079211",
+			want:    "9784758079211",
+			wantErr: false,
+		},
+		{
+			name:    "正常系: ハイフン付きISBN-13",
+			s:       "978-4-7580-7921-1",
+			want:    "9784758079211",
+			wantErr: false,
+		},
+		{
+			name:    "正常系: 979で始まるISBN-13",
+			s:       "9791000000008",
+			want:    "9791000000008",
+			wantErr: false,
+		},
+		{
+			name:    "正常系: ISBN-10",
+			s:       "4758079218",
+			want:    "9784758079211",
+			wantErr: false,
+		},
+		{
+			name:    "正常系: チェックディジットがXのISBN-10",
+			s:       "4-06-184881-X",
+			want:    "9784061848818",
+			wantErr: false,
+		},
+		{
+			name:    "正常系: 小文字のxと空白",
+			s:       "4 06 184881 x",
+			want:    "9784061848818",
+			wantErr: false,
+		},
+		{
+			name:       "異常系: ISBN-13のチェックディジットが不正",
+			s:          "9784758079212",
+			wantErr:    true,
+			wantErrStr: "ISBNが不正です",
+		},
+		{
+			name:       "異常系: ISBN-10のチェックディジットが不正",
+			s:          "4758079219",
+			wantErr:    true,
+			wantErrStr: "ISBNが不正です",
+		},
+		{
+			name:       "異常系: 978/979以外で始まる",
+			s:          "4901234567894",
+			wantErr:    true,
+			wantErrStr: "ISBNが不正です",
+		},
+		{
+			name:       "異常系: 桁数が不正",
+			s:          "97847580792",
+			wantErr:    true,
+			wantErrStr: "ISBNが不正です",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewISBN(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewISBN() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if got.String() != tt.want {
+				t.Errorf("NewISBN() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestISBN_ISBN10(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		want   string
+		wantOk bool
+	}{
+		{name: "正常系", s: "9784758079211", want: "4758079218", wantOk: true},
+		{name: "正常系: チェックディジットがX", s: "9784061848818", want: "406184881X", wantOk: true},
+		{name: "正常系: チェックディジットが0", s: "9784061848863", want: "4061848860", wantOk: true},
+		{name: "異常系: 979で始まる", s: "9791000000008", want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, err := NewISBN(tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := i.ISBN10()
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("ISBN10() = (%v, %v), want = (%v, %v)", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestISBN_Group(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		want   string
+		wantOk bool
+	}{
+		{name: "正常系: 1桁", s: "9784758079211", want: "4", wantOk: true},
+		{name: "正常系: 978-65", s: "9786555600001", want: "65", wantOk: true},
+		{name: "正常系: 978-66から978-69は3桁", s: "9786601234563", want: "660", wantOk: true},
+		{name: "正常系: 979", s: "9791000000008", want: "10", wantOk: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, err := NewISBN(tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := i.Group()
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Group() = (%v, %v), want = (%v, %v)", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestISBN_Hyphenate(t *testing.T) {
+	tests := []struct {
+		name          string
+		s             string
+		want          string
+		wantGroup     string
+		wantPublisher string
+		wantOk        bool
+	}{
+		{name: "正常系: 出版者記号4桁", s: "9784758079211", want: "978-4-7580-7921-1", wantGroup: "4", wantPublisher: "978-4-7580", wantOk: true},
+		{name: "正常系: 出版者記号2桁", s: "9784061848818", want: "978-4-06-184881-8", wantGroup: "4", wantPublisher: "978-4-06", wantOk: true},
+		{name: "正常系: 出版者記号3桁", s: "9784591123454", want: "978-4-591-12345-4", wantGroup: "4", wantPublisher: "978-4-591", wantOk: true},
+		{name: "正常系: 出版者記号5桁", s: "9784899991236", want: "978-4-89999-123-6", wantGroup: "4", wantPublisher: "978-4-89999", wantOk: true},
+		{name: "正常系: 出版者記号7桁", s: "9784999999910", want: "978-4-9999999-1-0", wantGroup: "4", wantPublisher: "978-4-9999999", wantOk: true},
+		{name: "異常系: 出版者記号の範囲データがない", s: "9791000000008", want: "", wantGroup: "10", wantOk: false},
+		{name: "異常系: 英語圏", s: "9780000000002", want: "", wantGroup: "0", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, err := NewISBN(tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := i.Hyphenate()
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Hyphenate() = (%v, %v), want = (%v, %v)", got, ok, tt.want, tt.wantOk)
+			}
+			if group, _ := i.Group(); group != tt.wantGroup {
+				t.Errorf("Group() = %v, want = %v", group, tt.wantGroup)
+			}
+			if parts, ok := i.Parts(); ok && parts.PublisherPrefix() != tt.wantPublisher {
+				t.Errorf("PublisherPrefix() = %v, want = %v", parts.PublisherPrefix(), tt.wantPublisher)
+			}
+		})
+	}
+}
+
+func Test_parseRanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{name: "正常系", data: "# コメント\n978\t0000000\t5999999\t1\n", wantErr: false},
+		{name: "異常系: 列が足りない", data: "978\t0000000\t5999999\n", wantErr: true},
+		{name: "異常系: 桁数が数値でない", data: "978\t0000000\t5999999\tx\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseRanges(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseRanges() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package isbn
+
+import (
+	_ "embed"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// rangeKeyLength は範囲を比較するときに使う桁数
+const rangeKeyLength = 7
+
+// ranges.tsv は RangeMessage.xml をこのディレクトリに置いて go generate で作り直す
+//
+//go:generate go run ../../../cmd/isbnranges -in RangeMessage.xml -out ranges.tsv
+//go:embed ranges.tsv
+var rangeData string
+
+type rangeRule struct {
+	start  string
+	end    string
+	length int
+}
+
+// rangeRules は接頭辞ごとの範囲。埋め込みデータなので読み込めなければ起動時に落とす
+var rangeRules = mustParseRanges(rangeData)
+
+func mustParseRanges(data string) map[string][]rangeRule {
+	rules, err := parseRanges(data)
+	if err != nil {
+		panic(err)
+	}
+	return rules
+}
+
+func parseRanges(data string) (map[string][]rangeRule, error) {
+	rules := map[string][]rangeRule{}
+	for n, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 4 || len(fields[1]) != rangeKeyLength || len(fields[2]) != rangeKeyLength {
+			return nil, fmt.Errorf("ranges.tsv:%d: invalid line", n+1)
+		}
+		length, err := strconv.Atoi(fields[3])
+		if err != nil || length < 1 || length > rangeKeyLength {
+			return nil, fmt.Errorf("ranges.tsv:%d: invalid length", n+1)
+		}
+		rules[fields[0]] = append(rules[fields[0]], rangeRule{start: fields[1], end: fields[2], length: length})
+	}
+	return rules, nil
+}
+
+// lookup は prefix に続く rest の先頭を何桁で区切るか返す
+func lookup(prefix, rest string) (int, bool) {
+	key := rest
+	if len(key) > rangeKeyLength {
+		key = key[:rangeKeyLength]
+	}
+	key += strings.Repeat("0", rangeKeyLength-len(key))
+	for _, r := range rangeRules[prefix] {
+		if r.start <= key && key <= r.end {
+			if r.length > len(rest) {
+				return 0, false
+			}
+			return r.length, true
+		}
+	}
+	return 0, false
+}
+
+// Parts はISBNを構成する要素
+type Parts struct {
+	// 接頭辞(978/979)
+	Prefix string
+	// 登録グループ記号(日本は4)
+	Group string
+	// 出版者記号
+	Registrant string
+	// 書名記号
+	Publication string
+	CheckDigit  string
+}
+
+// PublisherPrefix は出版者を表す 978-4-7580 のような部分を返す
+func (p Parts) PublisherPrefix() string {
+	return strings.Join([]string{p.Prefix, p.Group, p.Registrant}, "-")
+}
+
+func (p Parts) String() string {
+	return strings.Join([]string{p.Prefix, p.Group, p.Registrant, p.Publication, p.CheckDigit}, "-")
+}
+
+// Group は登録グループ記号を返す
+func (i ISBN) Group() (string, bool) {
+	prefix, body := i.code[:3], i.code[3:isbn13Length-1]
+	length, ok := lookup(prefix, body)
+	if !ok {
+		return "", false
+	}
+	return body[:length], true
+}
+
+// Parts は範囲データに従ってISBNを分割する
+// 範囲データにないグループの場合は false を返す
+func (i ISBN) Parts() (Parts, bool) {
+	group, ok := i.Group()
+	if !ok {
+		return Parts{}, false
+	}
+	prefix := i.code[:3]
+	rest := i.code[3+len(group) : isbn13Length-1]
+	length, ok := lookup(prefix+"-"+group, rest)
+	// 書名記号が1桁も残らない区切りは不正
+	if !ok || length == len(rest) {
+		return Parts{}, false
+	}
+	return Parts{
+		Prefix:      prefix,
+		Group:       group,
+		Registrant:  rest[:length],
+		Publication: rest[length:],
+		CheckDigit:  i.code[isbn13Length-1:],
+	}, true
+}
+
+// Hyphenate は 978-4-7580-7921-1 のように印字される形式で返す
+func (i ISBN) Hyphenate() (string, bool) {
+	parts, ok := i.Parts()
+	if !ok {
+		return "", false
+	}
+	return parts.String(), true
+}
@@ -0,0 +1,123 @@
+package isbnpublisher
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"strings"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/book"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/isbn"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/publish"
+)
+
+//go:embed publishers.tsv
+var bundledData string
+
+// Inferrer はISBNの出版者記号から出版社を推定する
+// NewBook の前に出版社の入力を補うために使う
+type Inferrer struct {
+	books     book.BookRepository
+	publishes publish.PublishRepository
+	// table は出版者記号(978-4-06 の形式)から出版社名への対応
+	table map[string]string
+}
+
+type Option func(*Inferrer)
+
+// WithBundledTable は同梱の対応表を推定に使う
+func WithBundledTable() Option {
+	return WithTable(parseTable(bundledData))
+}
+
+// WithTable は出版者記号から出版社名への対応表を推定に使う
+func WithTable(table map[string]string) Option {
+	return func(i *Inferrer) {
+		for prefix, name := range table {
+			i.table[prefix] = name
+		}
+	}
+}
+
+func NewInferrer(books book.BookRepository, publishes publish.PublishRepository, opts ...Option) *Inferrer {
+	i := &Inferrer{
+		books:     books,
+		publishes: publishes,
+		table:     map[string]string{},
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// Infer は同じ出版者記号の蔵書で最も多く使われている出版社を返す
+// 蔵書から推定できなければ対応表の出版社名で探し、見つからなければ errDomain.ErrNotFound を返す
+func (i *Inferrer) Infer(ctx context.Context, code isbn.ISBN) (*publish.Publish, error) {
+	parts, ok := code.Parts()
+	if !ok {
+		return nil, errDomain.ErrNotFound
+	}
+
+	p, err := i.inferFromBooks(ctx, parts)
+	if !errors.Is(err, errDomain.ErrNotFound) {
+		return p, err
+	}
+	return i.inferFromTable(ctx, parts)
+}
+
+func (i *Inferrer) inferFromBooks(ctx context.Context, parts isbn.Parts) (*publish.Publish, error) {
+	books, err := i.books.FindByISBNPrefix(ctx, strings.ReplaceAll(parts.PublisherPrefix(), "-", ""))
+	if err != nil {
+		return nil, err
+	}
+
+	// 同数の場合は先に見つかった出版社を優先する
+	counts := map[string]int{}
+	var best string
+	for _, b := range books {
+		counts[b.PublishID()]++
+		if counts[b.PublishID()] > counts[best] {
+			best = b.PublishID()
+		}
+	}
+	if best == "" {
+		return nil, errDomain.ErrNotFound
+	}
+	return i.publishes.FindByID(ctx, best)
+}
+
+func (i *Inferrer) inferFromTable(ctx context.Context, parts isbn.Parts) (*publish.Publish, error) {
+	name, ok := i.table[parts.PublisherPrefix()]
+	if !ok {
+		return nil, errDomain.ErrNotFound
+	}
+	publishes, err := i.publishes.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range publishes {
+		if p.Name() == name {
+			return p, nil
+		}
+	}
+	return nil, errDomain.ErrNotFound
+}
+
+// parseTable は同梱の対応表を読み込む。不正な行は読み飛ばす
+func parseTable(data string) map[string]string {
+	table := map[string]string{}
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		prefix, name, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		table[prefix] = strings.TrimSpace(name)
+	}
+	return table
+}
@@ -0,0 +1,158 @@
+package isbnpublisher
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/authorrole"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/book"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/isbn"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/publish"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+type fakeBookRepository struct {
+	book.BookRepository
+	books []*book.Book
+}
+
+func (r *fakeBookRepository) FindByISBNPrefix(_ context.Context, prefix string, _ ...repository.FindOption) ([]*book.Book, error) {
+	var books []*book.Book
+	for _, b := range r.books {
+		if code, ok := b.ISBN(); ok && strings.HasPrefix(code.String(), prefix) {
+			books = append(books, b)
+		}
+	}
+	return books, nil
+}
+
+type fakePublishRepository struct {
+	publish.PublishRepository
+	publishes []*publish.Publish
+}
+
+func (r *fakePublishRepository) FindByID(_ context.Context, id string, _ ...repository.FindOption) (*publish.Publish, error) {
+	for _, p := range r.publishes {
+		if p.ID() == id {
+			return p, nil
+		}
+	}
+	return nil, errDomain.ErrNotFound
+}
+
+func (r *fakePublishRepository) FindAll(_ context.Context, _ ...repository.FindOption) ([]*publish.Publish, error) {
+	return r.publishes, nil
+}
+
+func TestInferrer_Infer(t *testing.T) {
+	ichijinsha := mustPublish(t, "一迅社", "イチジンシャ")
+	other := mustPublish(t, "別の出版社", "ベツノシュッパンシャ")
+	kodansha := mustPublish(t, "講談社", "コウダンシャ")
+	publishes := &fakePublishRepository{publishes: []*publish.Publish{ichijinsha, other, kodansha}}
+	books := &fakeBookRepository{books: []*book.Book{
+		mustBook(t, "9784758079211", ichijinsha.ID()),
+		mustBook(t, "9784758012348", ichijinsha.ID()),
+		mustBook(t, "9784758099998", other.ID()),
+	}}
+
+	tests := []struct {
+		name    string
+		isbn    string
+		opts    []Option
+		want    *publish.Publish
+		wantErr error
+	}{
+		{
+			name: "正常系: 蔵書で最も多い出版社",
+			isbn: "9784758088886",
+			want: ichijinsha,
+		},
+		{
+			name: "正常系: 同梱の対応表",
+			isbn: "9784061848818",
+			opts: []Option{WithBundledTable()},
+			want: kodansha,
+		},
+		{
+			name:    "異常系: 対応表を使わない",
+			isbn:    "9784061848818",
+			wantErr: errDomain.ErrNotFound,
+		},
+		{
+			name:    "異常系: 対応表の出版社が未登録",
+			isbn:    "9784081234561",
+			opts:    []Option{WithBundledTable()},
+			wantErr: errDomain.ErrNotFound,
+		},
+		{
+			name:    "異常系: 範囲データにないグループ",
+			isbn:    "9791000000008",
+			opts:    []Option{WithBundledTable()},
+			wantErr: errDomain.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := isbn.NewISBN(tt.isbn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := NewInferrer(books, publishes, tt.opts...).Infer(context.Background(), code)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Infer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Infer() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseTable(t *testing.T) {
+	got := parseTable("# コメント\n978-4-06\t講談社\n不正な行\n")
+	if len(got) != 1 || got["978-4-06"] != "講談社" {
+		t.Errorf("parseTable() = %v", got)
+	}
+	if bundled := parseTable(bundledData); bundled["978-4-7580"] != "一迅社" {
+		t.Errorf("parseTable(bundledData)[978-4-7580] = %v, want = 一迅社", bundled["978-4-7580"])
+	}
+}
+
+func mustPublish(t *testing.T, name, namePhonic string) *publish.Publish {
+	t.Helper()
+	p, err := publish.NewPublish(clock.NewFixed(time.Now()), name, namePhonic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func mustBook(t *testing.T, code, publishID string) *book.Book {
+	t.Helper()
+	b, err := book.NewBook(
+		clock.NewFixed(time.Now()),
+		&code,
+		nil,
+		ulid.NewULID(),
+		publishID,
+		ulid.NewULID(),
+		"書籍タイトル",
+		"",
+		[]book.BookAuthor{book.NewBookAuthor(ulid.NewULID(), authorrole.Writer)},
+		nil,
+		time.Now(),
+		800,
+		nil,
+		"書籍の説明",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
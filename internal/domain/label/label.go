@@ -1,11 +1,12 @@
 package label
 
 import (
-	"fmt"
 	"time"
 	"unicode/utf8"
 
 	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/romaji"
 	"github.com/mitsu-yuki/shisho-backend/pkg/text"
 	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
 )
@@ -34,31 +35,34 @@ func newLabel(
 ) (*Label, error) {
 	// IDのバリデーション
 	if !ulid.IsValid(id) {
-		return nil, errDomain.NewError("レーベルIDが不正です")
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "id", "label.id.invalid")
 	}
 
 	// レーベル名のバリデーション
 	if utf8.RuneCountInString(name) < nameLengthMin {
-		return nil, errDomain.NewError(fmt.Sprintf("レーベル名は%d文字以上である必要があります", nameLengthMin))
+		return nil, errDomain.NewMessageError(errDomain.CodeTooShort, "name", "label.name.too_short", nameLengthMin)
 	}
 
+	// 読みはひらがなや半角カナで入力されてもカタカナに揃える
+	namePhonic = text.NormalizeReading(namePhonic)
+
 	// レーベル名(読み)のバリデーション
 	if utf8.RuneCountInString(namePhonic) < namePhonicLengthMin {
-		return nil, errDomain.NewError(fmt.Sprintf("レーベル名読みは%d文字以上である必要があります", namePhonicLengthMin))
+		return nil, errDomain.NewMessageError(errDomain.CodeTooShort, "namePhonic", "label.name_phonic.too_short", namePhonicLengthMin)
 	}
 
-	if !text.IsKatakana(namePhonic) {
-		return nil, errDomain.NewError("レーベル名読みはカタカナである必要があります")
+	if !text.IsReading(namePhonic) {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "namePhonic", "label.name_phonic.not_katakana")
 	}
 
 	// 日付のバリデーション(lastUpdateAtのほうが後か)
 	if lastUpdateAt.Before(createAt) {
-		return nil, errDomain.NewError("更新日は作成日よりも後である必要があります")
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "lastUpdateAt", "update_time.before_create")
 	}
 
 	// 削除フラグが立ってない もしくは 削除日は作成日よりも後であるか
 	if deletedAt != nil && deletedAt.Before(createAt) {
-		return nil, errDomain.NewError("削除日は作成日よりも後である必要があります")
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "deletedAt", "delete_time.before_create")
 	}
 
 	return &Label{
@@ -83,11 +87,95 @@ func Reconstruct(
 }
 
 func NewLabel(
+	clk clock.Clock,
 	name string,
 	namePhonic string,
-	createAt time.Time,
-	lastUpdateAt time.Time,
-	deletedAt *time.Time,
 ) (*Label, error) {
-	return newLabel(ulid.NewULID(), name, namePhonic, createAt, lastUpdateAt, deletedAt)
+	now := clk.Now()
+	return newLabel(ulid.NewULID(), name, namePhonic, now, now, nil)
+}
+
+func (l *Label) ID() string {
+	return l.id
+}
+
+func (l *Label) Name() string {
+	return l.name
+}
+
+func (l *Label) NamePhonic() string {
+	return l.namePhonic
+}
+
+// NameRomaji は読みをヘボン式のローマ字にしたもの
+func (l *Label) NameRomaji() string {
+	return romaji.FromKatakana(l.namePhonic)
+}
+
+// Slug はURLに使うローマ字。同じ読みであれば同じ値になる
+func (l *Label) Slug() string {
+	return romaji.Slug(l.NameRomaji())
+}
+
+func (l *Label) CreateAt() time.Time {
+	return l.createAt
+}
+
+func (l *Label) LastUpdateAt() time.Time {
+	return l.lastUpdateAt
+}
+
+func (l *Label) DeletedAt() *time.Time {
+	return l.deletedAt
+}
+
+// Rename は名前と読みを変更する
+func (l *Label) Rename(clk clock.Clock, name string, namePhonic string) error {
+	return l.update(clk, func(next *Label) {
+		next.name = name
+		next.namePhonic = namePhonic
+	})
+}
+
+func (l *Label) IsDeleted() bool {
+	return l.deletedAt != nil
+}
+
+// Delete は論理削除する
+func (l *Label) Delete(clk clock.Clock) error {
+	if l.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "already_deleted")
+	}
+	now := clk.Now()
+	return l.updateAt(now, func(next *Label) {
+		next.deletedAt = &now
+	})
+}
+
+// Restore は論理削除を取り消す
+func (l *Label) Restore(clk clock.Clock) error {
+	if !l.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "not_deleted")
+	}
+	return l.update(clk, func(next *Label) {
+		next.deletedAt = nil
+	})
+}
+
+// update は変更を加えたラベルをコンストラクタと同じバリデーションにかけ、
+// 問題がなければ更新日を現在時刻にして反映する
+func (l *Label) update(clk clock.Clock, change func(next *Label)) error {
+	return l.updateAt(clk.Now(), change)
+}
+
+// updateAt は更新日を now にして反映する。削除日など他の日時と揃える場合に使う
+func (l *Label) updateAt(now time.Time, change func(next *Label)) error {
+	next := *l
+	change(&next)
+	updated, err := newLabel(next.id, next.name, next.namePhonic, next.createAt, now, next.deletedAt)
+	if err != nil {
+		return err
+	}
+	*l = *updated
+	return nil
 }
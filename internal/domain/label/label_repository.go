@@ -0,0 +1,28 @@
+package label
+
+import (
+	"context"
+	"time"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/pkg/gojuon"
+)
+
+type LabelRepository interface {
+	Save(ctx context.Context, label *Label) error
+	FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*Label, error)
+	FindAll(ctx context.Context, opts ...repository.FindOption) ([]*Label, error)
+	// FindAllOrderByReading は読みの五十音順に返す
+	FindAllOrderByReading(ctx context.Context, opts ...repository.FindOption) ([]*Label, error)
+	// FindByIndexRow は読みが五十音の索引の row 行に入るものを五十音順に返す
+	FindByIndexRow(ctx context.Context, row gojuon.Row, opts ...repository.FindOption) ([]*Label, error)
+	// FindByRomaji はローマ字の読みに q を含むものを五十音順に返す
+	// q は空白やハイフン、長音の書き方の違いを無視して比べる。それらを除いて空になる q には何も返さない
+	FindByRomaji(ctx context.Context, q string, opts ...repository.FindOption) ([]*Label, error)
+	// FindBySlug は Slug が slug と一致するものを返す。同じ読みのものがあれば複数返す
+	FindBySlug(ctx context.Context, slug string, opts ...repository.FindOption) ([]*Label, error)
+	// Delete は行を物理削除する。論理削除はエンティティのDeleteの後にSaveする
+	Delete(ctx context.Context, id string) error
+	// Purge は before より前に論理削除された行を物理削除し、削除した件数を返す
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
@@ -7,9 +7,11 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
 )
 
-func TestNewLabel(t *testing.T) {
+func Test_newLabel(t *testing.T) {
 	now := time.Now()
 	earlier := now.Add(-1 * time.Hour)
 	later := now.Add(1 * time.Hour)
@@ -47,6 +49,44 @@ func TestNewLabel(t *testing.T) {
 			wantErr:    false,
 			wantErrStr: "",
 		},
+		{
+			name: "正常系: ひらがなをカタカナに揃える",
+			args: args{
+				name:         "test",
+				namePhonic:   "ジョージ・おーうぇる",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want: &Label{
+				name:         "test",
+				namePhonic:   "ジョージ・オーウェル",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			wantErr:    false,
+			wantErrStr: "",
+		},
+		{
+			name: "正常系: 半角カナと空白を揃える",
+			args: args{
+				name:         "test",
+				namePhonic:   " ﾑﾗｶﾐ  ﾊﾙｷ ",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want: &Label{
+				name:         "test",
+				namePhonic:   "ムラカミ　ハルキ",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			wantErr:    false,
+			wantErrStr: "",
+		},
 		{
 			name: "異常系: nameが不正",
 			args: args{
@@ -90,7 +130,7 @@ func TestNewLabel(t *testing.T) {
 			name: "異常系: namePhonicがカタカナでない",
 			args: args{
 				name:         "test",
-				namePhonic:   "てすと",
+				namePhonic:   "test",
 				createAt:     now,
 				lastUpdateAt: later,
 				deletedAt:    &later,
@@ -128,9 +168,9 @@ func TestNewLabel(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewLabel(tt.args.name, tt.args.namePhonic, tt.args.createAt, tt.args.lastUpdateAt, tt.args.deletedAt)
+			got, err := newLabel(ulid.NewULID(), tt.args.name, tt.args.namePhonic, tt.args.createAt, tt.args.lastUpdateAt, tt.args.deletedAt)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NewLabel() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("newLabel() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			if err != nil && err.Error() != tt.wantErrStr {
 				if diff := cmp.Diff(err.Error(), tt.wantErrStr); diff != "" {
@@ -144,7 +184,78 @@ func TestNewLabel(t *testing.T) {
 			)
 
 			if diff != "" {
-				t.Errorf("NewLabel() = %v, want = %v.\n error is %s", got, tt.want, diff)
+				t.Errorf("newLabel() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestNewLabel(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	got, err := NewLabel(clk, "test", "テスト")
+	if err != nil {
+		t.Fatalf("NewLabel() error = %v", err)
+	}
+	if !ulid.IsValid(got.ID()) {
+		t.Errorf("ID() = %s, want ULID", got.ID())
+	}
+	if !got.CreateAt().Equal(now) || !got.LastUpdateAt().Equal(now) {
+		t.Errorf("CreateAt() = %v, LastUpdateAt() = %v, want = %v", got.CreateAt(), got.LastUpdateAt(), now)
+	}
+	if got.IsDeleted() {
+		t.Errorf("IsDeleted() = true, want = false")
+	}
+}
+
+func TestLabel_Rename(t *testing.T) {
+	now := time.Now()
+	later := now.Add(1 * time.Hour)
+	tests := []struct {
+		name           string
+		newName        string
+		newNamePhonic  string
+		wantName       string
+		wantLastUpdate time.Time
+		wantErr        bool
+		wantErrStr     string
+	}{
+		{
+			name:           "正常系",
+			newName:        "new",
+			newNamePhonic:  "アタラシイ",
+			wantName:       "new",
+			wantLastUpdate: later,
+			wantErr:        false,
+		},
+		{
+			name:           "異常系: nameが不正",
+			newName:        "",
+			newNamePhonic:  "アタラシイ",
+			wantName:       "test",
+			wantLastUpdate: now,
+			wantErr:        true,
+			wantErrStr:     fmt.Sprintf("レーベル名は%d文字以上である必要があります", nameLengthMin),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewLabel(clock.NewFixed(now), "test", "テスト")
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = v.Rename(clock.NewFixed(later), tt.newName, tt.newNamePhonic)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Rename() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if v.Name() != tt.wantName {
+				t.Errorf("Name() = %v, want = %v", v.Name(), tt.wantName)
+			}
+			if !v.LastUpdateAt().Equal(tt.wantLastUpdate) {
+				t.Errorf("LastUpdateAt() = %v, want = %v", v.LastUpdateAt(), tt.wantLastUpdate)
 			}
 		})
 	}
@@ -1,11 +1,12 @@
 package publish
 
 import (
-	"fmt"
 	"time"
 	"unicode/utf8"
 
 	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/romaji"
 	"github.com/mitsu-yuki/shisho-backend/pkg/text"
 	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
 )
@@ -32,28 +33,36 @@ func newPublish(
 	lastUpdateAt time.Time,
 	deletedAt *time.Time,
 ) (*Publish, error) {
+	// IDのバリデーション
+	if !ulid.IsValid(id) {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "id", "publish.id.invalid")
+	}
+
 	// 名前のバリデーション
 	if utf8.RuneCountInString(name) < nameLengthMin {
-		return nil, errDomain.NewError(fmt.Sprintf("出版社名は%d文字以上である必要があります", nameLengthMin))
+		return nil, errDomain.NewMessageError(errDomain.CodeTooShort, "name", "publish.name.too_short", nameLengthMin)
 	}
 
+	// 読みはひらがなや半角カナで入力されてもカタカナに揃える
+	namePhonic = text.NormalizeReading(namePhonic)
+
 	// 名前(読み)のバリデーション
 	if utf8.RuneCountInString(namePhonic) < namePhonicLengthMin {
-		return nil, errDomain.NewError(fmt.Sprintf("著者名読みは%d文字以上である必要があります", namePhonicLengthMin))
+		return nil, errDomain.NewMessageError(errDomain.CodeTooShort, "namePhonic", "publish.name_phonic.too_short", namePhonicLengthMin)
 	}
 
-	if !text.IsKatakana(namePhonic) {
-		return nil, errDomain.NewError("著者名読みはカタカナである必要があります")
+	if !text.IsReading(namePhonic) {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "namePhonic", "publish.name_phonic.not_katakana")
 	}
 
 	// 日付のバリデーション(lastUpdateAtのほうが後か)
 	if lastUpdateAt.Before(createAt) {
-		return nil, errDomain.NewError("更新日は作成日よりも後である必要があります")
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "lastUpdateAt", "update_time.before_create")
 	}
 
 	// 削除フラグが立ってない もしくは 削除日は作成日よりも後であるか
 	if deletedAt != nil && deletedAt.Before(createAt) {
-		return nil, errDomain.NewError("削除日は作成日よりも後である必要があります")
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "deletedAt", "delete_time.before_create")
 	}
 	return &Publish{
 		id:           id,
@@ -77,11 +86,95 @@ func Reconstruct(
 }
 
 func NewPublish(
+	clk clock.Clock,
 	name string,
 	namePhonic string,
-	createAt time.Time,
-	lastUpdateAt time.Time,
-	deletedAt *time.Time,
 ) (*Publish, error) {
-	return newPublish(ulid.NewULID(), name, namePhonic, createAt, lastUpdateAt, deletedAt)
+	now := clk.Now()
+	return newPublish(ulid.NewULID(), name, namePhonic, now, now, nil)
+}
+
+func (p *Publish) ID() string {
+	return p.id
+}
+
+func (p *Publish) Name() string {
+	return p.name
+}
+
+func (p *Publish) NamePhonic() string {
+	return p.namePhonic
+}
+
+// NameRomaji は読みをヘボン式のローマ字にしたもの
+func (p *Publish) NameRomaji() string {
+	return romaji.FromKatakana(p.namePhonic)
+}
+
+// Slug はURLに使うローマ字。同じ読みであれば同じ値になる
+func (p *Publish) Slug() string {
+	return romaji.Slug(p.NameRomaji())
+}
+
+func (p *Publish) CreateAt() time.Time {
+	return p.createAt
+}
+
+func (p *Publish) LastUpdateAt() time.Time {
+	return p.lastUpdateAt
+}
+
+func (p *Publish) DeletedAt() *time.Time {
+	return p.deletedAt
+}
+
+// Rename は名前と読みを変更する
+func (p *Publish) Rename(clk clock.Clock, name string, namePhonic string) error {
+	return p.update(clk, func(next *Publish) {
+		next.name = name
+		next.namePhonic = namePhonic
+	})
+}
+
+func (p *Publish) IsDeleted() bool {
+	return p.deletedAt != nil
+}
+
+// Delete は論理削除する
+func (p *Publish) Delete(clk clock.Clock) error {
+	if p.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "already_deleted")
+	}
+	now := clk.Now()
+	return p.updateAt(now, func(next *Publish) {
+		next.deletedAt = &now
+	})
+}
+
+// Restore は論理削除を取り消す
+func (p *Publish) Restore(clk clock.Clock) error {
+	if !p.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "not_deleted")
+	}
+	return p.update(clk, func(next *Publish) {
+		next.deletedAt = nil
+	})
+}
+
+// update は変更を加えた出版社をコンストラクタと同じバリデーションにかけ、
+// 問題がなければ更新日を現在時刻にして反映する
+func (p *Publish) update(clk clock.Clock, change func(next *Publish)) error {
+	return p.updateAt(clk.Now(), change)
+}
+
+// updateAt は更新日を now にして反映する。削除日など他の日時と揃える場合に使う
+func (p *Publish) updateAt(now time.Time, change func(next *Publish)) error {
+	next := *p
+	change(&next)
+	updated, err := newPublish(next.id, next.name, next.namePhonic, next.createAt, now, next.deletedAt)
+	if err != nil {
+		return err
+	}
+	*p = *updated
+	return nil
 }
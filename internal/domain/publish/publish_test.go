@@ -7,9 +7,11 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
 )
 
-func TestNewPublish(t *testing.T) {
+func Test_newPublish(t *testing.T) {
 	now := time.Now()
 	earlier := now.Add(-1 * time.Hour)
 	later := now.Add(1 * time.Hour)
@@ -46,6 +48,42 @@ func TestNewPublish(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "正常系: ひらがなをカタカナに揃える",
+			args: args{
+				name:         "test",
+				namePhonic:   "ジョージ・おーうぇる",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want: &Publish{
+				name:         "test",
+				namePhonic:   "ジョージ・オーウェル",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "正常系: 半角カナと空白を揃える",
+			args: args{
+				name:         "test",
+				namePhonic:   " ﾑﾗｶﾐ  ﾊﾙｷ ",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want: &Publish{
+				name:         "test",
+				namePhonic:   "ムラカミ　ハルキ",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			wantErr: false,
+		},
 		{
 			name: "異常系: nameが不正",
 			args: args{
@@ -70,20 +108,20 @@ func TestNewPublish(t *testing.T) {
 			},
 			want:       nil,
 			wantErr:    true,
-			wantErrStr: fmt.Sprintf("著者名読みは%d文字以上である必要があります", namePhonicLengthMin),
+			wantErrStr: fmt.Sprintf("出版社名読みは%d文字以上である必要があります", namePhonicLengthMin),
 		},
 		{
 			name: "異常系: namePhonicがカタカナでない",
 			args: args{
 				name:         "test",
-				namePhonic:   "てすと",
+				namePhonic:   "test",
 				createAt:     now,
 				lastUpdateAt: now,
 				deletedAt:    nil,
 			},
 			want:       nil,
 			wantErr:    true,
-			wantErrStr: "著者名読みはカタカナである必要があります",
+			wantErrStr: "出版社名読みはカタカナである必要があります",
 		},
 		{
 			name: "異常系: 更新日が不正",
@@ -114,7 +152,7 @@ func TestNewPublish(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewPublish(tt.args.name, tt.args.namePhonic, tt.args.createAt, tt.args.lastUpdateAt, tt.args.deletedAt)
+			got, err := newPublish(ulid.NewULID(), tt.args.name, tt.args.namePhonic, tt.args.createAt, tt.args.lastUpdateAt, tt.args.deletedAt)
 			if err != nil && err.Error() != tt.wantErrStr {
 				if diff := cmp.Diff(err.Error(), tt.wantErrStr); diff != "" {
 					t.Errorf("got: %v, want: %s.\n error is %s", err.Error(), tt.wantErrStr, diff)
@@ -127,7 +165,114 @@ func TestNewPublish(t *testing.T) {
 			)
 
 			if diff != "" {
-				t.Errorf("NewPublish() = %v, want = %v.\n error is %s", got, tt.want, diff)
+				t.Errorf("newPublish() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestNewPublish(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	got, err := NewPublish(clk, "test", "テスト")
+	if err != nil {
+		t.Fatalf("NewPublish() error = %v", err)
+	}
+	if !ulid.IsValid(got.ID()) {
+		t.Errorf("ID() = %s, want ULID", got.ID())
+	}
+	if !got.CreateAt().Equal(now) || !got.LastUpdateAt().Equal(now) {
+		t.Errorf("CreateAt() = %v, LastUpdateAt() = %v, want = %v", got.CreateAt(), got.LastUpdateAt(), now)
+	}
+	if got.IsDeleted() {
+		t.Errorf("IsDeleted() = true, want = false")
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		id         string
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:    "正常系",
+			id:      ulid.NewULID(),
+			wantErr: false,
+		},
+		{
+			name:       "異常系: IDが不正",
+			id:         "id",
+			wantErr:    true,
+			wantErrStr: "出版社IDが不正です",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Reconstruct(tt.id, "test", "テスト", now, now, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Reconstruct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if err == nil && got.ID() != tt.id {
+				t.Errorf("Reconstruct().ID() = %s, want = %s", got.ID(), tt.id)
+			}
+		})
+	}
+}
+
+func TestPublish_Rename(t *testing.T) {
+	now := time.Now()
+	later := now.Add(1 * time.Hour)
+	tests := []struct {
+		name           string
+		newName        string
+		newNamePhonic  string
+		wantName       string
+		wantLastUpdate time.Time
+		wantErr        bool
+		wantErrStr     string
+	}{
+		{
+			name:           "正常系",
+			newName:        "new",
+			newNamePhonic:  "アタラシイ",
+			wantName:       "new",
+			wantLastUpdate: later,
+			wantErr:        false,
+		},
+		{
+			name:           "異常系: nameが不正",
+			newName:        "",
+			newNamePhonic:  "アタラシイ",
+			wantName:       "test",
+			wantLastUpdate: now,
+			wantErr:        true,
+			wantErrStr:     fmt.Sprintf("出版社名は%d文字以上である必要があります", nameLengthMin),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewPublish(clock.NewFixed(now), "test", "テスト")
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = v.Rename(clock.NewFixed(later), tt.newName, tt.newNamePhonic)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Rename() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if v.Name() != tt.wantName {
+				t.Errorf("Name() = %v, want = %v", v.Name(), tt.wantName)
+			}
+			if !v.LastUpdateAt().Equal(tt.wantLastUpdate) {
+				t.Errorf("LastUpdateAt() = %v, want = %v", v.LastUpdateAt(), tt.wantLastUpdate)
 			}
 		})
 	}
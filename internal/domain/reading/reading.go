@@ -0,0 +1,178 @@
+package reading
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mitsu-yuki/shisho-backend/pkg/text"
+)
+
+//go:embed dictionary.tsv
+var bundledData string
+
+// maxSuggestions は1つの名前に対して返す候補の最大数
+const maxSuggestions = 5
+
+// Suggester は名前やタイトルから読みの候補を返す
+// 候補は text.NormalizeReading で揃えたカタカナで、見つからなければ空を返す
+type Suggester interface {
+	Suggest(ctx context.Context, s string) ([]string, error)
+}
+
+// Dictionary は辞書の最長一致で読みを組み立てる
+// かなはそのまま読みとして使い、辞書にない漢字を含む場合は候補を返さない
+type Dictionary struct {
+	// entries は表記から読みの候補への対応
+	entries map[string][]string
+	// maxLength は表記の最大の文字数
+	maxLength int
+}
+
+type Option func(*Dictionary)
+
+// WithBundledDictionary は同梱の辞書を使う
+func WithBundledDictionary() Option {
+	return WithEntries(parseDictionary(bundledData))
+}
+
+// WithEntries は表記から読みへの対応を辞書に追加する
+// 同梱の辞書にある表記を指定した場合は上書きする
+func WithEntries(entries map[string][]string) Option {
+	return func(d *Dictionary) {
+		for word, readings := range entries {
+			d.entries[word] = readings
+			d.maxLength = max(d.maxLength, utf8.RuneCountInString(word))
+		}
+	}
+}
+
+func NewDictionary(opts ...Option) *Dictionary {
+	d := &Dictionary{entries: map[string][]string{}}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Suggest は長い表記から優先して辞書を引き、組み合わせた読みを返す
+func (d *Dictionary) Suggest(ctx context.Context, s string) ([]string, error) {
+	var suggestions []string
+	w := walker{
+		d:    d,
+		rest: []rune(strings.TrimSpace(s)),
+		dead: map[int]bool{},
+		yield: func(reading string) bool {
+			reading = text.NormalizeReading(reading)
+			if text.IsReading(reading) && !slices.Contains(suggestions, reading) {
+				suggestions = append(suggestions, reading)
+			}
+			return len(suggestions) < maxSuggestions
+		},
+	}
+	w.walk(0, "")
+	return suggestions, ctx.Err()
+}
+
+// walker は辞書を引きながら読みの組み合わせを探す
+type walker struct {
+	d    *Dictionary
+	rest []rune
+	// dead は読みを組み立てられないとわかった位置
+	dead map[int]bool
+	// yield は読みを組み立て終わるたびに呼ばれ、false を返したら探索をやめる
+	yield func(string) bool
+}
+
+// walk は i 文字目以降の読みを組み立てる
+// 探索を続けるかと、読みを1つ以上組み立てられたかを返す
+func (w *walker) walk(i int, prefix string) (bool, bool) {
+	if i == len(w.rest) {
+		return w.yield(prefix), true
+	}
+	if w.dead[i] {
+		return true, false
+	}
+	if passThrough(w.rest[i]) {
+		cont, found := w.walk(i+1, prefix+string(w.rest[i]))
+		w.dead[i] = !found
+		return cont, found
+	}
+	found := false
+	for n := min(w.d.maxLength, len(w.rest)-i); n > 0; n-- {
+		for _, reading := range w.d.entries[string(w.rest[i:i+n])] {
+			cont, ok := w.walk(i+n, prefix+reading)
+			found = found || ok
+			if !cont {
+				return false, found
+			}
+		}
+	}
+	w.dead[i] = !found
+	return true, found
+}
+
+// passThrough はかなと区切りの記号をそのまま読みに使うか調べる
+func passThrough(r rune) bool {
+	if unicode.IsSpace(r) {
+		return true
+	}
+	normalized := []rune(text.NormalizeReading(string(r)))
+	return len(normalized) == 1 && (text.IsReading(string(normalized)) || strings.ContainsRune("ー・＝", normalized[0]))
+}
+
+// Chain は前の Suggester から順に候補を集め、重複を除いて返す
+// 形態素解析器の候補を辞書の候補より優先したい場合などに使う
+// 失敗した Suggester は飛ばし、すべて失敗した場合のみエラーを返す
+func Chain(suggesters ...Suggester) Suggester {
+	return chain(suggesters)
+}
+
+type chain []Suggester
+
+func (c chain) Suggest(ctx context.Context, s string) ([]string, error) {
+	var suggestions []string
+	var errs []error
+	for _, suggester := range c {
+		got, err := suggester.Suggest(ctx, s)
+		if err != nil {
+			// MeCabがない場合などでも、他の Suggester の候補は返す
+			errs = append(errs, err)
+			continue
+		}
+		for _, reading := range got {
+			if !slices.Contains(suggestions, reading) {
+				suggestions = append(suggestions, reading)
+			}
+		}
+	}
+	if len(c) > 0 && len(errs) == len(c) {
+		return nil, errors.Join(errs...)
+	}
+	return suggestions, nil
+}
+
+// parseDictionary は同梱の辞書を読み込む。不正な行は読み飛ばす
+func parseDictionary(data string) map[string][]string {
+	entries := map[string][]string{}
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		word, readings, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		for _, reading := range strings.Split(readings, ",") {
+			if reading = strings.TrimSpace(reading); reading != "" {
+				entries[word] = append(entries[word], reading)
+			}
+		}
+	}
+	return entries
+}
@@ -0,0 +1,84 @@
+package reading
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDictionary_Suggest(t *testing.T) {
+	d := NewDictionary(WithBundledDictionary(), WithEntries(map[string][]string{"漱石": {"ソーセキ"}}))
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{name: "正常系", s: "村上春樹", want: []string{"ムラカミハルキ"}},
+		{name: "正常系: 空白で区切る", s: "東野 圭吾", want: []string{"ヒガシノ　ケイゴ"}},
+		{name: "正常系: かなを含む", s: "進撃の巨人", want: []string{"シンゲキノキョジン"}},
+		{name: "正常系: 読みが複数", s: "河野", want: []string{"コウノ", "カワノ"}},
+		{name: "正常系: 最長一致", s: "講談社文庫", want: []string{"コウダンシャブンコ"}},
+		{name: "正常系: 追加した辞書で上書き", s: "夏目漱石", want: []string{"ナツメソーセキ"}},
+		{name: "正常系: 辞書にない漢字", s: "謎の人物", want: nil},
+		{name: "正常系: 英字", s: "KADOKAWA", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.Suggest(context.Background(), tt.s)
+			if err != nil {
+				t.Fatalf("Suggest() error = %v", err)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Suggest() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+type fakeSuggester struct {
+	suggestions []string
+	err         error
+}
+
+func (f fakeSuggester) Suggest(ctx context.Context, s string) ([]string, error) {
+	return f.suggestions, f.err
+}
+
+func TestChain(t *testing.T) {
+	errAnalyzer := errors.New("analyzer failed")
+	tests := []struct {
+		name       string
+		suggesters []Suggester
+		want       []string
+		wantErr    error
+	}{
+		{
+			name:       "正常系: 前の候補を優先して重複を除く",
+			suggesters: []Suggester{fakeSuggester{suggestions: []string{"カワノ"}}, fakeSuggester{suggestions: []string{"コウノ", "カワノ"}}},
+			want:       []string{"カワノ", "コウノ"},
+		},
+		{
+			name:       "正常系: 失敗したものは飛ばす",
+			suggesters: []Suggester{fakeSuggester{err: errAnalyzer}, fakeSuggester{suggestions: []string{"コウノ"}}},
+			want:       []string{"コウノ"},
+		},
+		{
+			name:       "異常系: すべて失敗",
+			suggesters: []Suggester{fakeSuggester{err: errAnalyzer}, fakeSuggester{err: errors.New("dictionary failed")}},
+			wantErr:    errAnalyzer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Chain(tt.suggesters...).Suggest(context.Background(), "河野")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Suggest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Suggest() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package repository
+
+// FindOptions はリポジトリの検索条件
+// デフォルトでは論理削除された行を含めない
+type FindOptions struct {
+	IncludeDeleted bool
+}
+
+type FindOption func(*FindOptions)
+
+// WithDeleted は論理削除された行も検索対象に含める
+func WithDeleted() FindOption {
+	return func(o *FindOptions) {
+		o.IncludeDeleted = true
+	}
+}
+
+func NewFindOptions(opts ...FindOption) FindOptions {
+	var o FindOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,72 @@
+package series
+
+import (
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Gap はシリーズで所持していない巻
+type Gap struct {
+	// Missing は1巻から所持している最新巻までの間で抜けている巻
+	Missing []Volume
+	// Unowned は発売済みだが所持していない巻
+	Unowned []Volume
+}
+
+// IsEmpty は抜けている巻がないか
+func (g Gap) IsEmpty() bool {
+	return len(g.Missing) == 0 && len(g.Unowned) == 0
+}
+
+// FindGap はシリーズの所持巻と発売済みの巻を突き合わせて、所持していない巻を返す
+// released がnilの場合は所持巻から分かる抜けのみを返す
+func FindGap(s *Series, released []Volume) Gap {
+	owned := map[volumeKey]struct{}{}
+	ownedNumbers := map[float64]struct{}{}
+	latest := 0.0
+	for _, book := range s.books {
+		owned[book.volume.key()] = struct{}{}
+		if number, ok := book.volume.Number(); ok {
+			ownedNumbers[number] = struct{}{}
+			latest = math.Max(latest, number)
+		}
+	}
+
+	var gap Gap
+	// 巻数が整数の巻のみ抜けを推測できる(10.5巻のような巻は発売済み一覧で判断する)
+	for n := 1; n <= int(math.Floor(latest)); n++ {
+		if _, ok := ownedNumbers[float64(n)]; ok {
+			continue
+		}
+		volume, _ := NewVolume(strconv.Itoa(n))
+		gap.Missing = append(gap.Missing, volume)
+	}
+
+	for _, volume := range released {
+		if _, ok := owned[volume.key()]; ok {
+			continue
+		}
+		gap.Unowned = append(gap.Unowned, volume)
+	}
+	sort.SliceStable(gap.Unowned, func(i, j int) bool {
+		return gap.Unowned[i].Less(gap.Unowned[j])
+	})
+	return gap
+}
+
+// VolumesUpTo は1巻からlatest巻までの巻を返す
+// 最新巻の巻数のみ分かっている場合の発売済みの巻として使う
+// latest が1未満の場合はnilを返し、巻数の上限を超える場合は上限までを返す
+func VolumesUpTo(latest int) []Volume {
+	if latest <= 0 {
+		return nil
+	}
+	latest = min(latest, volumeNumberMax)
+	volumes := make([]Volume, 0, latest)
+	for n := 1; n <= latest; n++ {
+		volume, _ := NewVolume(strconv.Itoa(n))
+		volumes = append(volumes, volume)
+	}
+	return volumes
+}
@@ -0,0 +1,109 @@
+package series
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+func TestFindGap(t *testing.T) {
+	volumes := func(ss ...string) []Volume {
+		var vs []Volume
+		for _, s := range ss {
+			vs = append(vs, mustVolume(t, s))
+		}
+		return vs
+	}
+	series := func(ss ...string) *Series {
+		var books SeriesBooks
+		for _, v := range volumes(ss...) {
+			books = append(books, SeriesBook{bookID: ulid.NewULID(), volume: v})
+		}
+		return &Series{books: books.sorted()}
+	}
+	tests := []struct {
+		name        string
+		series      *Series
+		released    []Volume
+		wantMissing []string
+		wantUnowned []string
+	}{
+		{
+			name:        "正常系: 抜けなし",
+			series:      series("1", "2", "3"),
+			released:    nil,
+			wantMissing: nil,
+			wantUnowned: nil,
+		},
+		{
+			name:        "正常系: 途中の巻が抜けている",
+			series:      series("1", "3", "6"),
+			released:    nil,
+			wantMissing: []string{"2", "4", "5"},
+			wantUnowned: nil,
+		},
+		{
+			name:        "正常系: 小数巻と特別巻は抜けの推測に使わない",
+			series:      series("2", "2.5", "外伝"),
+			released:    nil,
+			wantMissing: []string{"1"},
+			wantUnowned: nil,
+		},
+		{
+			name:        "正常系: 発売済みで未所持の巻",
+			series:      series("1", "2"),
+			released:    volumes("外伝", "4", "3", "2.5", "2", "1"),
+			wantMissing: nil,
+			wantUnowned: []string{"2.5", "3", "4", "外伝"},
+		},
+		{
+			name:        "正常系: 最新巻まで",
+			series:      series("2"),
+			released:    VolumesUpTo(3),
+			wantMissing: []string{"1"},
+			wantUnowned: []string{"1", "3"},
+		},
+		{
+			name:        "正常系: 表記の異なる同じ巻数は所持している",
+			series:      series("01", "2.0"),
+			released:    VolumesUpTo(3),
+			wantMissing: nil,
+			wantUnowned: []string{"3"},
+		},
+		{
+			name:        "正常系: 最新巻が負",
+			series:      series("1"),
+			released:    VolumesUpTo(-1),
+			wantMissing: nil,
+			wantUnowned: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindGap(tt.series, tt.released)
+			if diff := cmp.Diff(volumeStrings(got.Missing), tt.wantMissing); diff != "" {
+				t.Errorf("Missing = %v, want = %v.\n error is %s", got.Missing, tt.wantMissing, diff)
+			}
+			if diff := cmp.Diff(volumeStrings(got.Unowned), tt.wantUnowned); diff != "" {
+				t.Errorf("Unowned = %v, want = %v.\n error is %s", got.Unowned, tt.wantUnowned, diff)
+			}
+		})
+	}
+}
+
+func volumeStrings(volumes []Volume) []string {
+	var ss []string
+	for _, v := range volumes {
+		ss = append(ss, v.String())
+	}
+	return ss
+}
+
+func TestVolumesUpTo_Max(t *testing.T) {
+	got := VolumesUpTo(volumeNumberMax + 1)
+	if len(got) != volumeNumberMax || got[len(got)-1].String() != strconv.Itoa(volumeNumberMax) {
+		t.Errorf("VolumesUpTo() = %d volumes, want = %d", len(got), volumeNumberMax)
+	}
+}
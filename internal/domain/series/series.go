@@ -1,11 +1,14 @@
 package series
 
 import (
-	"fmt"
+	"slices"
+	"sort"
 	"time"
 	"unicode/utf8"
 
 	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/seriesstatus"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
 	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
 )
 
@@ -18,7 +21,7 @@ type Series struct {
 	id           string
 	name         string
 	books        SeriesBooks
-	statusID     string
+	status       seriesstatus.Status
 	createAt     time.Time
 	lastUpdateAt time.Time
 	deletedAt    *time.Time
@@ -28,45 +31,60 @@ func newSeries(
 	id string,
 	name string,
 	books []SeriesBook,
-	statusID string,
+	status seriesstatus.Status,
 	createAt time.Time,
 	lastUpdateAt time.Time,
 	deletedAt *time.Time,
 ) (*Series, error) {
 	// シリーズIDのバリデーション
 	if !ulid.IsValid(id) {
-		return nil, errDomain.NewError("シリーズIDが不正です")
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "id", "series.id.invalid")
 	}
 
 	// シリーズ名のバリデーション
 	if utf8.RuneCountInString(name) < seriesNameLengthMin {
-		return nil, errDomain.NewError(fmt.Sprintf("タイトル名は%d文字以上である必要があります", seriesNameLengthMin))
+		return nil, errDomain.NewMessageError(errDomain.CodeTooShort, "name", "series.name.too_short", seriesNameLengthMin)
 	}
 
 	// シリーズが内包する作品数のバリデーション
 	if len(books) < seriesBooksLengthMin {
-		return nil, errDomain.NewError(fmt.Sprintf("シリーズ作品は%d作品以上である必要があります", seriesBooksLengthMin))
+		return nil, errDomain.NewMessageError(errDomain.CodeTooShort, "books", "series.books.too_short", seriesBooksLengthMin)
+	}
+	volumes := map[volumeKey]struct{}{}
+	for _, book := range books {
+		if !ulid.IsValid(book.bookID) {
+			return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "bookID", "book.id.invalid")
+		}
+
+		// 巻数はシリーズ内で一意である必要がある
+		if book.volume.String() == "" {
+			return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "volume", "series.volume.invalid")
+		}
+		if _, ok := volumes[book.volume.key()]; ok {
+			return nil, errDomain.NewMessageError(errDomain.CodeDuplicate, "volume", "series.volume.duplicate", book.volume)
+		}
+		volumes[book.volume.key()] = struct{}{}
 	}
 
 	// ステータスのバリデーション
-	if !ulid.IsValid(statusID) {
-		return nil, errDomain.NewError("ステータスIDが不正です")
+	if !status.IsValid() {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "status", "series.status.invalid")
 	}
 
 	// 日付のバリデーション(lastUpdateAtのほうが後か)
 	if lastUpdateAt.Before(createAt) {
-		return nil, errDomain.NewError("更新日は作成日よりも後である必要があります")
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "lastUpdateAt", "update_time.before_create")
 	}
 
 	// 削除フラグが立ってない もしくは 削除日は作成日よりも後であるか
 	if deletedAt != nil && deletedAt.Before(createAt) {
-		return nil, errDomain.NewError("削除日は作成日よりも後である必要があります")
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "deletedAt", "delete_time.before_create")
 	}
 	return &Series{
 		id:           id,
 		name:         name,
-		books:        books,
-		statusID:     statusID,
+		books:        SeriesBooks(books).sorted(),
+		status:       status,
 		createAt:     createAt,
 		lastUpdateAt: lastUpdateAt,
 		deletedAt:    deletedAt,
@@ -77,23 +95,22 @@ func Reconstruct(
 	id string,
 	name string,
 	books []SeriesBook,
-	statusID string,
+	status seriesstatus.Status,
 	createAt time.Time,
 	lastUpdateAt time.Time,
 	deletedAt *time.Time,
 ) (*Series, error) {
-	return newSeries(id, name, books, statusID, createAt, lastUpdateAt, deletedAt)
+	return newSeries(id, name, books, status, createAt, lastUpdateAt, deletedAt)
 }
 
 func NewSeries(
+	clk clock.Clock,
 	name string,
 	books []SeriesBook,
-	statusID string,
-	createAt time.Time,
-	lastUpdateAt time.Time,
-	deletedAt *time.Time,
+	status seriesstatus.Status,
 ) (*Series, error) {
-	return newSeries(ulid.NewULID(), name, books, statusID, createAt, lastUpdateAt, deletedAt)
+	now := clk.Now()
+	return newSeries(ulid.NewULID(), name, books, status, now, now, nil)
 }
 
 func (s *Series) ID() string {
@@ -105,10 +122,123 @@ func (s *Series) Name() string {
 }
 
 func (s *Series) Books() []SeriesBook {
-	return s.books
+	return slices.Clone(s.books)
+}
+
+func (s *Series) Status() seriesstatus.Status {
+	return s.status
+}
+
+// IsFinished はシリーズが完結または打ち切りになっているか
+func (s *Series) IsFinished() bool {
+	return s.status.IsFinished()
+}
+
+// Complete はシリーズを完結にする
+func (s *Series) Complete(clk clock.Clock) error {
+	return s.transitionTo(clk, seriesstatus.Completed)
+}
+
+// Suspend はシリーズを休載にする
+func (s *Series) Suspend(clk clock.Clock) error {
+	return s.transitionTo(clk, seriesstatus.Hiatus)
+}
+
+// Resume は休載中のシリーズを連載中に戻す
+func (s *Series) Resume(clk clock.Clock) error {
+	return s.transitionTo(clk, seriesstatus.Ongoing)
+}
+
+// Cancel はシリーズを打ち切りにする
+func (s *Series) Cancel(clk clock.Clock) error {
+	return s.transitionTo(clk, seriesstatus.Cancelled)
+}
+
+// Reopen は完結・打ち切りになったシリーズを連載中に戻す
+func (s *Series) Reopen(clk clock.Clock) error {
+	if !s.status.CanReopen() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "status", "series.status.cannot_resume", s.status)
+	}
+	return s.update(clk, func(next *Series) {
+		next.status = seriesstatus.Ongoing
+	})
+}
+
+func (s *Series) transitionTo(clk clock.Clock, status seriesstatus.Status) error {
+	if !s.status.CanTransitionTo(status) {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "status", "series.status.cannot_change", s.status, status)
+	}
+	return s.update(clk, func(next *Series) {
+		next.status = status
+	})
+}
+
+func (s *Series) Rename(clk clock.Clock, name string) error {
+	return s.update(clk, func(next *Series) {
+		next.name = name
+	})
+}
+
+// AddBook はシリーズに作品を追加する
+func (s *Series) AddBook(clk clock.Clock, book SeriesBook) error {
+	return s.update(clk, func(next *Series) {
+		next.books = append(append(SeriesBooks{}, s.books...), book)
+	})
 }
-func (s *Series) StatusID() string {
-	return s.statusID
+
+// RemoveBook はシリーズから作品を外す
+func (s *Series) RemoveBook(clk clock.Clock, bookID string) error {
+	for i, book := range s.books {
+		if book.bookID == bookID {
+			return s.update(clk, func(next *Series) {
+				next.books = append(append(SeriesBooks{}, s.books[:i]...), s.books[i+1:]...)
+			})
+		}
+	}
+	return errDomain.NewMessageError(errDomain.CodeConflict, "bookID", "series.book.not_found")
+}
+
+func (s *Series) IsDeleted() bool {
+	return s.deletedAt != nil
+}
+
+// Delete は論理削除する
+func (s *Series) Delete(clk clock.Clock) error {
+	if s.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "already_deleted")
+	}
+	now := clk.Now()
+	return s.updateAt(now, func(next *Series) {
+		next.deletedAt = &now
+	})
+}
+
+// Restore は論理削除を取り消す
+func (s *Series) Restore(clk clock.Clock) error {
+	if !s.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "not_deleted")
+	}
+	return s.update(clk, func(next *Series) {
+		next.deletedAt = nil
+	})
+}
+
+// update は変更を加えたシリーズをコンストラクタと同じバリデーションにかけ、
+// 問題がなければ更新日を現在時刻にして反映する
+func (s *Series) update(clk clock.Clock, change func(next *Series)) error {
+	return s.updateAt(clk.Now(), change)
+}
+
+// updateAt は更新日を now にして反映する。削除日など他の日時と揃える場合に使う
+func (s *Series) updateAt(now time.Time, change func(next *Series)) error {
+	next := *s
+	change(&next)
+	updated, err := newSeries(next.id, next.name, next.books, next.status, next.createAt, now, next.deletedAt)
+	if err != nil {
+		return err
+	}
+	*s = *updated
+	return nil
 }
 
 func (s *Series) CreateAt() time.Time {
@@ -119,10 +249,30 @@ func (s *Series) LastUpdateAt() time.Time {
 	return s.lastUpdateAt
 }
 
+func (s *Series) DeletedAt() *time.Time {
+	return s.deletedAt
+}
+
 type SeriesBooks []SeriesBook
 
 type SeriesBook struct {
 	bookID string
+	volume Volume
+}
+
+func NewSeriesBook(bookID string, volume Volume) SeriesBook {
+	return SeriesBook{
+		bookID: bookID,
+		volume: volume,
+	}
+}
+
+func (s SeriesBook) BookID() string {
+	return s.bookID
+}
+
+func (s SeriesBook) Volume() Volume {
+	return s.volume
 }
 
 func (s SeriesBooks) SeriesIDs() []string {
@@ -132,3 +282,12 @@ func (s SeriesBooks) SeriesIDs() []string {
 	}
 	return books
 }
+
+// sorted は巻数の読む順に並べ替えた作品リストを返す
+func (s SeriesBooks) sorted() SeriesBooks {
+	books := append(SeriesBooks{}, s...)
+	sort.SliceStable(books, func(i, j int) bool {
+		return books[i].volume.Less(books[j].volume)
+	})
+	return books
+}
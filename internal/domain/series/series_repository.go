@@ -0,0 +1,23 @@
+package series
+
+import (
+	"context"
+	"time"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+)
+
+// ErrStatusNotFound はシリーズのステータスが series_status に登録されていない場合に Save が返す
+var ErrStatusNotFound = errDomain.NewMessageError(errDomain.CodeNotFound, "status", "series.status.not_found")
+
+type SeriesRepository interface {
+	// Save はステータスが登録されていない場合に ErrStatusNotFound を返す
+	Save(ctx context.Context, series *Series) error
+	FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*Series, error)
+	FindAll(ctx context.Context, opts ...repository.FindOption) ([]*Series, error)
+	// Delete は行を物理削除する。論理削除はエンティティのDeleteの後にSaveする
+	Delete(ctx context.Context, id string) error
+	// Purge は before より前に論理削除された行を物理削除し、削除した件数を返す
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
@@ -7,20 +7,23 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/seriesstatus"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
 	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
 )
 
-func TestNewSeries(t *testing.T) {
+func Test_newSeries(t *testing.T) {
 	bookID1 := ulid.NewULID()
 	bookID2 := ulid.NewULID()
-	statusID := ulid.NewULID()
+	volume1 := mustVolume(t, "1")
+	volume2 := mustVolume(t, "2")
 	now := time.Now()
 	earlier := now.Add(-1 * time.Hour)
 	later := now.Add(1 * time.Hour)
 	type args struct {
 		name         string
 		books        SeriesBooks
-		statusID     string
+		status       seriesstatus.Status
 		createAt     time.Time
 		lastUpdateAt time.Time
 		deletedAt    *time.Time
@@ -39,12 +42,14 @@ func TestNewSeries(t *testing.T) {
 				books: []SeriesBook{
 					{
 						bookID: bookID1,
+						volume: volume1,
 					},
 					{
 						bookID: bookID2,
+						volume: volume2,
 					},
 				},
-				statusID:     statusID,
+				status:       seriesstatus.Ongoing,
 				createAt:     earlier,
 				lastUpdateAt: now,
 				deletedAt:    &later,
@@ -54,12 +59,14 @@ func TestNewSeries(t *testing.T) {
 				books: []SeriesBook{
 					{
 						bookID: bookID1,
+						volume: volume1,
 					},
 					{
 						bookID: bookID2,
+						volume: volume2,
 					},
 				},
-				statusID:     statusID,
+				status:       seriesstatus.Ongoing,
 				createAt:     earlier,
 				lastUpdateAt: now,
 				deletedAt:    &later,
@@ -74,9 +81,10 @@ func TestNewSeries(t *testing.T) {
 				books: []SeriesBook{
 					{
 						bookID: bookID1,
+						volume: volume1,
 					},
 				},
-				statusID:     statusID,
+				status:       seriesstatus.Ongoing,
 				createAt:     now,
 				lastUpdateAt: now,
 				deletedAt:    nil,
@@ -90,7 +98,7 @@ func TestNewSeries(t *testing.T) {
 			args: args{
 				name:         "テスト",
 				books:        []SeriesBook{},
-				statusID:     statusID,
+				status:       seriesstatus.Ongoing,
 				createAt:     now,
 				lastUpdateAt: now,
 				deletedAt:    nil,
@@ -100,22 +108,145 @@ func TestNewSeries(t *testing.T) {
 			wantErrStr: fmt.Sprintf("シリーズ作品は%d作品以上である必要があります", seriesBooksLengthMin),
 		},
 		{
-			name: "異常系: ステータスIDが不正",
+			name: "異常系: 書籍IDが不正",
+			args: args{
+				name: "テスト",
+				books: []SeriesBook{
+					{
+						bookID: "bookID",
+						volume: volume1,
+					},
+				},
+				status:       seriesstatus.Ongoing,
+				createAt:     now,
+				lastUpdateAt: later,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "書籍IDが不正です",
+		},
+		{
+			name: "正常系: 巻数順に並ぶ",
+			args: args{
+				name: "テスト",
+				books: []SeriesBook{
+					{
+						bookID: bookID2,
+						volume: volume2,
+					},
+					{
+						bookID: bookID1,
+						volume: volume1,
+					},
+				},
+				status:       seriesstatus.Ongoing,
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want: &Series{
+				name: "テスト",
+				books: []SeriesBook{
+					{
+						bookID: bookID1,
+						volume: volume1,
+					},
+					{
+						bookID: bookID2,
+						volume: volume2,
+					},
+				},
+				status:       seriesstatus.Ongoing,
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			wantErr:    false,
+			wantErrStr: "",
+		},
+		{
+			name: "異常系: 巻数が重複",
 			args: args{
 				name: "テスト",
 				books: []SeriesBook{
 					{
 						bookID: bookID1,
+						volume: volume1,
+					},
+					{
+						bookID: bookID2,
+						volume: volume1,
 					},
 				},
-				statusID:     "",
+				status:       seriesstatus.Ongoing,
 				createAt:     now,
 				lastUpdateAt: now,
 				deletedAt:    nil,
 			},
 			want:       nil,
 			wantErr:    true,
-			wantErrStr: "ステータスIDが不正です",
+			wantErrStr: "1巻が重複しています",
+		},
+		{
+			name: "異常系: 表記の異なる同じ巻数が重複",
+			args: args{
+				name: "テスト",
+				books: []SeriesBook{
+					{
+						bookID: bookID1,
+						volume: volume1,
+					},
+					{
+						bookID: bookID2,
+						volume: mustVolume(t, "01"),
+					},
+				},
+				status:       seriesstatus.Ongoing,
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "01巻が重複しています",
+		},
+		{
+			name: "異常系: 巻数が未設定",
+			args: args{
+				name: "テスト",
+				books: []SeriesBook{
+					{
+						bookID: bookID1,
+					},
+				},
+				status:       seriesstatus.Ongoing,
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "巻数が不正です",
+		},
+		{
+			name: "異常系: ステータスが不正",
+			args: args{
+				name: "テスト",
+				books: []SeriesBook{
+					{
+						bookID: bookID1,
+						volume: volume1,
+					},
+				},
+				status:       "",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "ステータスが不正です",
 		},
 		{
 			name: "異常系: 更新日が不正",
@@ -124,9 +255,10 @@ func TestNewSeries(t *testing.T) {
 				books: []SeriesBook{
 					{
 						bookID: bookID1,
+						volume: volume1,
 					},
 				},
-				statusID:     statusID,
+				status:       seriesstatus.Ongoing,
 				createAt:     later,
 				lastUpdateAt: now,
 				deletedAt:    nil,
@@ -142,9 +274,10 @@ func TestNewSeries(t *testing.T) {
 				books: []SeriesBook{
 					{
 						bookID: bookID1,
+						volume: volume1,
 					},
 				},
-				statusID:     statusID,
+				status:       seriesstatus.Ongoing,
 				createAt:     now,
 				lastUpdateAt: now,
 				deletedAt:    &earlier,
@@ -156,9 +289,9 @@ func TestNewSeries(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewSeries(tt.args.name, tt.args.books, tt.args.statusID, tt.args.createAt, tt.args.lastUpdateAt, tt.args.deletedAt)
+			got, err := newSeries(ulid.NewULID(), tt.args.name, tt.args.books, tt.args.status, tt.args.createAt, tt.args.lastUpdateAt, tt.args.deletedAt)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NewSeries() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("newSeries() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			if err != nil && err.Error() != tt.wantErrStr {
 				if diff := cmp.Diff(err.Error(), tt.wantErrStr); diff != "" {
@@ -167,13 +300,287 @@ func TestNewSeries(t *testing.T) {
 			}
 			diff := cmp.Diff(
 				got, tt.want,
-				cmp.AllowUnexported(Series{}, SeriesBook{}),
+				cmp.AllowUnexported(Series{}, SeriesBook{}, Volume{}),
 				cmpopts.IgnoreFields(Series{}, "id"),
 			)
 
 			if diff != "" {
-				t.Errorf("NewSeries() = %v, want = %v.\n error is %s", got, tt.want, diff)
+				t.Errorf("newSeries() = %v, want = %v.\n error is %s", got, tt.want, diff)
 			}
 		})
 	}
 }
+
+func TestNewSeries(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	got, err := NewSeries(clk, "テスト", []SeriesBook{NewSeriesBook(ulid.NewULID(), mustVolume(t, "1"))}, seriesstatus.Ongoing)
+	if err != nil {
+		t.Fatalf("NewSeries() error = %v", err)
+	}
+	if !ulid.IsValid(got.ID()) {
+		t.Errorf("ID() = %s, want ULID", got.ID())
+	}
+	if !got.CreateAt().Equal(now) || !got.LastUpdateAt().Equal(now) {
+		t.Errorf("CreateAt() = %v, LastUpdateAt() = %v, want = %v", got.CreateAt(), got.LastUpdateAt(), now)
+	}
+	if got.IsDeleted() {
+		t.Errorf("IsDeleted() = true, want = false")
+	}
+}
+
+func TestSeries_StatusTransition(t *testing.T) {
+	tests := []struct {
+		name       string
+		from       seriesstatus.Status
+		operate    func(s *Series, clk clock.Clock) error
+		want       seriesstatus.Status
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:    "正常系: 連載中から完結",
+			from:    seriesstatus.Ongoing,
+			operate: (*Series).Complete,
+			want:    seriesstatus.Completed,
+			wantErr: false,
+		},
+		{
+			name:    "正常系: 連載中から休載",
+			from:    seriesstatus.Ongoing,
+			operate: (*Series).Suspend,
+			want:    seriesstatus.Hiatus,
+			wantErr: false,
+		},
+		{
+			name:    "正常系: 休載から再開",
+			from:    seriesstatus.Hiatus,
+			operate: (*Series).Resume,
+			want:    seriesstatus.Ongoing,
+			wantErr: false,
+		},
+		{
+			name:    "正常系: 休載から打ち切り",
+			from:    seriesstatus.Hiatus,
+			operate: (*Series).Cancel,
+			want:    seriesstatus.Cancelled,
+			wantErr: false,
+		},
+		{
+			name:    "正常系: 完結から連載再開",
+			from:    seriesstatus.Completed,
+			operate: (*Series).Reopen,
+			want:    seriesstatus.Ongoing,
+			wantErr: false,
+		},
+		{
+			name:       "異常系: 完結から再開",
+			from:       seriesstatus.Completed,
+			operate:    (*Series).Resume,
+			want:       seriesstatus.Completed,
+			wantErr:    true,
+			wantErrStr: "完結から連載中には変更できません",
+		},
+		{
+			name:       "異常系: 打ち切りから休載",
+			from:       seriesstatus.Cancelled,
+			operate:    (*Series).Suspend,
+			want:       seriesstatus.Cancelled,
+			wantErr:    true,
+			wantErrStr: "打ち切りから休載には変更できません",
+		},
+		{
+			name:       "異常系: 連載中から連載再開",
+			from:       seriesstatus.Ongoing,
+			operate:    (*Series).Reopen,
+			want:       seriesstatus.Ongoing,
+			wantErr:    true,
+			wantErrStr: "連載中のシリーズは連載再開できません",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestSeries(t)
+			s.status = tt.from
+			err := tt.operate(s, clock.NewFixed(time.Now()))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if s.Status() != tt.want {
+				t.Errorf("Status() = %v, want = %v", s.Status(), tt.want)
+			}
+		})
+	}
+}
+
+func mustVolume(t *testing.T, s string) Volume {
+	t.Helper()
+	v, err := NewVolume(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestSeries_AddBook(t *testing.T) {
+	now := time.Now()
+	later := now.Add(1 * time.Hour)
+	bookID := ulid.NewULID()
+	tests := []struct {
+		name        string
+		volume      string
+		wantVolumes []string
+		wantErr     bool
+		wantErrStr  string
+	}{
+		{
+			name:        "正常系: 巻数順に追加される",
+			volume:      "1.5",
+			wantVolumes: []string{"1", "1.5", "2"},
+			wantErr:     false,
+		},
+		{
+			name:        "異常系: 巻数が重複",
+			volume:      "2",
+			wantVolumes: []string{"1", "2"},
+			wantErr:     true,
+			wantErrStr:  "2巻が重複しています",
+		},
+		{
+			name:        "異常系: 表記の異なる同じ巻数が重複",
+			volume:      "2.0",
+			wantVolumes: []string{"1", "2"},
+			wantErr:     true,
+			wantErrStr:  "2.0巻が重複しています",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestSeries(t)
+			s.createAt, s.lastUpdateAt = now, now
+			err := s.AddBook(clock.NewFixed(later), NewSeriesBook(bookID, mustVolume(t, tt.volume)))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AddBook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			var volumes []string
+			for _, b := range s.Books() {
+				volumes = append(volumes, b.Volume().String())
+			}
+			if diff := cmp.Diff(volumes, tt.wantVolumes); diff != "" {
+				t.Errorf("Books() = %v, want = %v.\n error is %s", volumes, tt.wantVolumes, diff)
+			}
+			if err == nil && !s.LastUpdateAt().Equal(later) {
+				t.Errorf("LastUpdateAt() = %v, want = %v", s.LastUpdateAt(), later)
+			}
+		})
+	}
+}
+
+func TestSeries_RemoveBook(t *testing.T) {
+	s := newTestSeries(t)
+	first := s.Books()[0].BookID()
+	last := s.Books()[1].BookID()
+
+	if err := s.RemoveBook(clock.NewFixed(time.Now()), first); err != nil {
+		t.Errorf("RemoveBook() error = %v", err)
+	}
+	if len(s.Books()) != 1 || s.Books()[0].BookID() != last {
+		t.Errorf("Books() = %v, want only %s", s.Books(), last)
+	}
+
+	// 最後の作品は外せない
+	err := s.RemoveBook(clock.NewFixed(time.Now()), last)
+	if want := fmt.Sprintf("シリーズ作品は%d作品以上である必要があります", seriesBooksLengthMin); err == nil || err.Error() != want {
+		t.Errorf("RemoveBook() error = %v, want %s", err, want)
+	}
+
+	err = s.RemoveBook(clock.NewFixed(time.Now()), ulid.NewULID())
+	if want := "シリーズに含まれていない作品です"; err == nil || err.Error() != want {
+		t.Errorf("RemoveBook() error = %v, want %s", err, want)
+	}
+}
+
+func TestSeries_Books(t *testing.T) {
+	// 返したスライスを書き換えても、バリデーションを通らずにシリーズが変わらないようにする
+	s := newTestSeries(t)
+	first := s.Books()[0].BookID()
+	books := s.Books()
+	books[0] = NewSeriesBook(ulid.NewULID(), books[0].Volume())
+	if got := s.Books()[0].BookID(); got != first {
+		t.Errorf("Books()[0].BookID() = %v, want = %v", got, first)
+	}
+}
+
+func newTestSeries(t *testing.T) *Series {
+	t.Helper()
+	now := time.Now()
+	s, err := NewSeries(
+		clock.NewFixed(now),
+		"テスト",
+		[]SeriesBook{
+			NewSeriesBook(ulid.NewULID(), mustVolume(t, "1")),
+			NewSeriesBook(ulid.NewULID(), mustVolume(t, "2")),
+		},
+		seriesstatus.Ongoing,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSeries_DeleteRestore(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	v := newTestSeries(t)
+	v.createAt, v.lastUpdateAt = now, now
+
+	if err := v.Restore(clk); err == nil || err.Error() != "削除されていません" {
+		t.Errorf("Restore() error = %v, want 削除されていません", err)
+	}
+
+	clk.Advance(1 * time.Hour)
+	if err := v.Delete(clk); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !v.IsDeleted() || !v.DeletedAt().Equal(clk.Now()) || !v.LastUpdateAt().Equal(clk.Now()) {
+		t.Errorf("DeletedAt() = %v, LastUpdateAt() = %v, want = %v", v.DeletedAt(), v.LastUpdateAt(), clk.Now())
+	}
+	if err := v.Delete(clk); err == nil || err.Error() != "既に削除されています" {
+		t.Errorf("Delete() error = %v, want 既に削除されています", err)
+	}
+
+	clk.Advance(1 * time.Hour)
+	if err := v.Restore(clk); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if v.IsDeleted() || !v.LastUpdateAt().Equal(clk.Now()) {
+		t.Errorf("DeletedAt() = %v, LastUpdateAt() = %v, want = nil, %v", v.DeletedAt(), v.LastUpdateAt(), clk.Now())
+	}
+}
+
+// tickingClock は呼び出すたびに1秒進む時計
+type tickingClock struct {
+	now time.Time
+}
+
+func (c *tickingClock) Now() time.Time {
+	c.now = c.now.Add(time.Second)
+	return c.now
+}
+
+func TestSeries_Delete_SameTime(t *testing.T) {
+	v := newTestSeries(t)
+	if err := v.Delete(&tickingClock{now: time.Now()}); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !v.DeletedAt().Equal(v.LastUpdateAt()) {
+		t.Errorf("DeletedAt() = %v, LastUpdateAt() = %v, want same time", v.DeletedAt(), v.LastUpdateAt())
+	}
+}
@@ -0,0 +1,101 @@
+package series
+
+import (
+	"regexp"
+	"strconv"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+)
+
+// 巻数は 1, 10.5 のような数値、上/中/下、3上 のような組み合わせを受け付ける
+// それ以外の表記(外伝、番外編など)は特別巻として扱う
+var volumePattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)?(上|中|下)?$`)
+
+// 巻数の上限。抜けている巻を1巻ずつ数えるため、大きすぎる巻数は受け付けない
+const volumeNumberMax = 9999
+
+var partOrder = map[string]int{
+	"":  0,
+	"上": 1,
+	"中": 2,
+	"下": 3,
+}
+
+type Volume struct {
+	raw     string
+	number  float64
+	part    string
+	special bool
+}
+
+func NewVolume(s string) (Volume, error) {
+	if s == "" {
+		return Volume{}, errDomain.NewMessageError(errDomain.CodeInvalid, "volume", "series.volume.invalid")
+	}
+
+	matches := volumePattern.FindStringSubmatch(s)
+	if matches == nil {
+		return Volume{raw: s, special: true}, nil
+	}
+
+	var number float64
+	if matches[1] != "" {
+		n, err := strconv.ParseFloat(matches[1], 64)
+		if err != nil {
+			return Volume{}, errDomain.NewMessageError(errDomain.CodeInvalid, "volume", "series.volume.invalid")
+		}
+		if n > volumeNumberMax {
+			return Volume{}, errDomain.NewMessageError(errDomain.CodeOutOfRange, "volume", "series.volume.out_of_range", volumeNumberMax)
+		}
+		number = n
+	}
+	return Volume{raw: s, number: number, part: matches[2]}, nil
+}
+
+func (v Volume) String() string {
+	return v.raw
+}
+
+// Number は数値で表される巻の巻数を返す
+func (v Volume) Number() (float64, bool) {
+	if v.special || v.raw == v.part {
+		return 0, false
+	}
+	return v.number, true
+}
+
+// volumeKey は表記によらず同じ巻を同じ値にする比較用のキー
+// 数値の巻は 1, 01, 1.0 を同じ巻とし、特別巻は表記で比べる
+type volumeKey struct {
+	number    float64
+	hasNumber bool
+	part      string
+	special   string
+}
+
+func (v Volume) key() volumeKey {
+	if v.special {
+		return volumeKey{special: v.raw}
+	}
+	number, ok := v.Number()
+	return volumeKey{number: number, hasNumber: ok, part: v.part}
+}
+
+func (v Volume) IsSpecial() bool {
+	return v.special
+}
+
+// Less は読む順でvがoより前か
+// 数値の巻、上中下の順に並べ、特別巻はその後ろに表記順で並べる
+func (v Volume) Less(o Volume) bool {
+	if v.special != o.special {
+		return !v.special
+	}
+	if v.special {
+		return v.raw < o.raw
+	}
+	if v.number != o.number {
+		return v.number < o.number
+	}
+	return partOrder[v.part] < partOrder[o.part]
+}
@@ -0,0 +1,73 @@
+package series
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewVolume(t *testing.T) {
+	tests := []struct {
+		name        string
+		s           string
+		wantNumber  float64
+		wantNumeric bool
+		wantSpecial bool
+		wantErr     bool
+	}{
+		{name: "正常系: 整数", s: "10", wantNumber: 10, wantNumeric: true},
+		{name: "正常系: 小数", s: "10.5", wantNumber: 10.5, wantNumeric: true},
+		{name: "正常系: 上下巻", s: "上", wantNumeric: false},
+		{name: "正常系: 巻数と上下", s: "3下", wantNumber: 3, wantNumeric: true},
+		{name: "正常系: 特別巻", s: "外伝", wantSpecial: true},
+		{name: "正常系: 上限の巻数", s: "9999", wantNumber: 9999, wantNumeric: true},
+		{name: "異常系: 空文字", s: "", wantErr: true},
+		{name: "異常系: 上限を超える巻数", s: "100000000", wantErr: true},
+		{name: "異常系: 上限を超える巻数と上下", s: "10000上", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewVolume(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewVolume() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			number, ok := got.Number()
+			if number != tt.wantNumber || ok != tt.wantNumeric {
+				t.Errorf("Number() = %v, %v, want = %v, %v", number, ok, tt.wantNumber, tt.wantNumeric)
+			}
+			if got.IsSpecial() != tt.wantSpecial {
+				t.Errorf("IsSpecial() = %v, want = %v", got.IsSpecial(), tt.wantSpecial)
+			}
+			if got.String() != tt.s {
+				t.Errorf("String() = %v, want = %v", got.String(), tt.s)
+			}
+		})
+	}
+}
+
+func TestVolume_Less(t *testing.T) {
+	var volumes []Volume
+	for _, s := range []string{"外伝", "10", "2", "10.5", "下", "上", "番外編", "1"} {
+		v, err := NewVolume(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		volumes = append(volumes, v)
+	}
+	sort.SliceStable(volumes, func(i, j int) bool {
+		return volumes[i].Less(volumes[j])
+	})
+
+	var got []string
+	for _, v := range volumes {
+		got = append(got, v.String())
+	}
+	want := []string{"上", "下", "1", "2", "10", "10.5", "外伝", "番外編"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("sorted = %v, want = %v.\n error is %s", got, want, diff)
+	}
+}
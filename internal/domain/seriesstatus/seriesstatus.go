@@ -0,0 +1,59 @@
+package seriesstatus
+
+import (
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+)
+
+type Status string
+
+const (
+	Ongoing   Status = "連載中"
+	Completed Status = "完結"
+	Hiatus    Status = "休載"
+	Cancelled Status = "打ち切り"
+)
+
+// transitions は通常の操作で遷移できる先
+// 完結・打ち切りからの再開は Reopen でのみ許可する
+var transitions = map[Status][]Status{
+	Ongoing:   {Completed, Hiatus, Cancelled},
+	Hiatus:    {Ongoing, Completed, Cancelled},
+	Completed: {},
+	Cancelled: {},
+}
+
+func NewStatus(s string) (Status, error) {
+	status := Status(s)
+	if !status.IsValid() {
+		return "", errDomain.NewMessageError(errDomain.CodeInvalid, "status", "series.status.invalid")
+	}
+	return status, nil
+}
+
+func (s Status) String() string {
+	return string(s)
+}
+
+func (s Status) IsValid() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+// IsFinished はこれ以上巻が出ない状態か
+func (s Status) IsFinished() bool {
+	return s == Completed || s == Cancelled
+}
+
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, to := range transitions[s] {
+		if to == next {
+			return true
+		}
+	}
+	return false
+}
+
+// CanReopen は終了した状態から連載中に戻せるか
+func (s Status) CanReopen() bool {
+	return s.IsFinished()
+}
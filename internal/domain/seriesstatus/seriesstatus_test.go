@@ -0,0 +1,66 @@
+package seriesstatus
+
+import "testing"
+
+func TestNewStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		want       Status
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name:    "正常系",
+			s:       "連載中",
+			want:    Ongoing,
+			wantErr: false,
+		},
+		{
+			name:       "異常系: 未定義のステータス",
+			s:          "未定",
+			want:       "",
+			wantErr:    true,
+			wantErrStr: "ステータスが不正です",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewStatus(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				t.Errorf("got: %v, want: %s", err.Error(), tt.wantErrStr)
+			}
+			if got != tt.want {
+				t.Errorf("NewStatus() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from Status
+		to   Status
+		want bool
+	}{
+		{from: Ongoing, to: Completed, want: true},
+		{from: Ongoing, to: Hiatus, want: true},
+		{from: Ongoing, to: Cancelled, want: true},
+		{from: Hiatus, to: Ongoing, want: true},
+		{from: Hiatus, to: Completed, want: true},
+		{from: Completed, to: Ongoing, want: false},
+		{from: Completed, to: Hiatus, want: false},
+		{from: Cancelled, to: Ongoing, want: false},
+		{from: Ongoing, to: Ongoing, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"→"+string(tt.to), func(t *testing.T) {
+			if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+				t.Errorf("CanTransitionTo() = %v, want = %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,17 @@
 package size
 
-import "time"
+import (
+	"time"
+	"unicode/utf8"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+const (
+	nameLengthMin = 1
+)
 
 type Size struct {
 	id           string
@@ -10,6 +21,60 @@ type Size struct {
 	deletedAt    *time.Time
 }
 
+func newSize(
+	id string,
+	name string,
+	createAt time.Time,
+	lastUpdateAt time.Time,
+	deletedAt *time.Time,
+) (*Size, error) {
+	// IDのバリデーション
+	if !ulid.IsValid(id) {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "id", "size.id.invalid")
+	}
+
+	// 判型名のバリデーション
+	if utf8.RuneCountInString(name) < nameLengthMin {
+		return nil, errDomain.NewMessageError(errDomain.CodeTooShort, "name", "size.name.too_short", nameLengthMin)
+	}
+
+	// 日付のバリデーション(lastUpdateAtのほうが後か)
+	if lastUpdateAt.Before(createAt) {
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "lastUpdateAt", "update_time.before_create")
+	}
+
+	// 削除フラグが立ってない もしくは 削除日は作成日よりも後であるか
+	if deletedAt != nil && deletedAt.Before(createAt) {
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "deletedAt", "delete_time.before_create")
+	}
+
+	return &Size{
+		id:           id,
+		name:         name,
+		createAt:     createAt,
+		lastUpdateAt: lastUpdateAt,
+		deletedAt:    deletedAt,
+	}, nil
+}
+
+func Reconstruct(
+	id string,
+	name string,
+	createAt time.Time,
+	lastUpdateAt time.Time,
+	deletedAt *time.Time,
+) (*Size, error) {
+	return newSize(id, name, createAt, lastUpdateAt, deletedAt)
+}
+
+func NewSize(
+	clk clock.Clock,
+	name string,
+) (*Size, error) {
+	now := clk.Now()
+	return newSize(ulid.NewULID(), name, now, now, nil)
+}
+
 func (s *Size) ID() string {
 	return s.id
 }
@@ -29,3 +94,53 @@ func (s *Size) LastUpdateAt() time.Time {
 func (s *Size) DeletedAt() *time.Time {
 	return s.deletedAt
 }
+
+// Rename は名前を変更する
+func (s *Size) Rename(clk clock.Clock, name string) error {
+	return s.update(clk, func(next *Size) {
+		next.name = name
+	})
+}
+
+func (s *Size) IsDeleted() bool {
+	return s.deletedAt != nil
+}
+
+// Delete は論理削除する
+func (s *Size) Delete(clk clock.Clock) error {
+	if s.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "already_deleted")
+	}
+	now := clk.Now()
+	return s.updateAt(now, func(next *Size) {
+		next.deletedAt = &now
+	})
+}
+
+// Restore は論理削除を取り消す
+func (s *Size) Restore(clk clock.Clock) error {
+	if !s.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "not_deleted")
+	}
+	return s.update(clk, func(next *Size) {
+		next.deletedAt = nil
+	})
+}
+
+// update は変更を加えた判型をコンストラクタと同じバリデーションにかけ、
+// 問題がなければ更新日を現在時刻にして反映する
+func (s *Size) update(clk clock.Clock, change func(next *Size)) error {
+	return s.updateAt(clk.Now(), change)
+}
+
+// updateAt は更新日を now にして反映する。削除日など他の日時と揃える場合に使う
+func (s *Size) updateAt(now time.Time, change func(next *Size)) error {
+	next := *s
+	change(&next)
+	updated, err := newSize(next.id, next.name, next.createAt, now, next.deletedAt)
+	if err != nil {
+		return err
+	}
+	*s = *updated
+	return nil
+}
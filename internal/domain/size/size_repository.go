@@ -0,0 +1,18 @@
+package size
+
+import (
+	"context"
+	"time"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+)
+
+type SizeRepository interface {
+	Save(ctx context.Context, size *Size) error
+	FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*Size, error)
+	FindAll(ctx context.Context, opts ...repository.FindOption) ([]*Size, error)
+	// Delete は行を物理削除する。論理削除はエンティティのDeleteの後にSaveする
+	Delete(ctx context.Context, id string) error
+	// Purge は before より前に論理削除された行を物理削除し、削除した件数を返す
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
@@ -1 +1,134 @@
 package size
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+func Test_newSize(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-1 * time.Hour)
+	later := now.Add(1 * time.Hour)
+	type args struct {
+		name         string
+		createAt     time.Time
+		lastUpdateAt time.Time
+		deletedAt    *time.Time
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		want       *Size
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name: "正常系",
+			args: args{
+				name:         "文庫",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want: &Size{
+				name:         "文庫",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			wantErr:    false,
+			wantErrStr: "",
+		},
+		{
+			name: "異常系: nameが不正",
+			args: args{
+				name:         "",
+				createAt:     now,
+				lastUpdateAt: later,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: fmt.Sprintf("判型名は%d文字以上である必要があります", nameLengthMin),
+		},
+		{
+			name: "異常系: 更新日が不正",
+			args: args{
+				name:         "文庫",
+				createAt:     now,
+				lastUpdateAt: earlier,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "更新日は作成日よりも後である必要があります",
+		},
+		{
+			name: "異常系: 削除日が不正",
+			args: args{
+				name:         "文庫",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    &earlier,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "削除日は作成日よりも後である必要があります",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newSize(ulid.NewULID(), tt.args.name, tt.args.createAt, tt.args.lastUpdateAt, tt.args.deletedAt)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				if diff := cmp.Diff(err.Error(), tt.wantErrStr); diff != "" {
+					t.Errorf("got: %v, want: %s.\n error is %s", err.Error(), tt.wantErrStr, diff)
+				}
+			}
+			diff := cmp.Diff(
+				got, tt.want,
+				cmp.AllowUnexported(Size{}),
+				cmpopts.IgnoreFields(Size{}, "id"),
+			)
+
+			if diff != "" {
+				t.Errorf("newSize() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestNewSize(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	got, err := NewSize(clk, "A5")
+	if err != nil {
+		t.Fatalf("NewSize() error = %v", err)
+	}
+	if !ulid.IsValid(got.ID()) {
+		t.Errorf("ID() = %s, want ULID", got.ID())
+	}
+	if !got.CreateAt().Equal(now) || !got.LastUpdateAt().Equal(now) {
+		t.Errorf("CreateAt() = %v, LastUpdateAt() = %v, want = %v", got.CreateAt(), got.LastUpdateAt(), now)
+	}
+	if got.IsDeleted() {
+		t.Errorf("IsDeleted() = true, want = false")
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	now := time.Now()
+	_, err := Reconstruct("id", "文庫", now, now, nil)
+	if err == nil || err.Error() != "判型IDが不正です" {
+		t.Errorf("Reconstruct() error = %v, want 判型IDが不正です", err)
+	}
+}
@@ -0,0 +1,146 @@
+package tag
+
+import (
+	"time"
+	"unicode/utf8"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+const (
+	nameLengthMin = 1
+)
+
+type Tag struct {
+	id           string
+	name         string
+	createAt     time.Time
+	lastUpdateAt time.Time
+	deletedAt    *time.Time
+}
+
+func newTag(
+	id string,
+	name string,
+	createAt time.Time,
+	lastUpdateAt time.Time,
+	deletedAt *time.Time,
+) (*Tag, error) {
+	// IDのバリデーション
+	if !ulid.IsValid(id) {
+		return nil, errDomain.NewMessageError(errDomain.CodeInvalid, "id", "tag.id.invalid")
+	}
+
+	// タグ名のバリデーション
+	if utf8.RuneCountInString(name) < nameLengthMin {
+		return nil, errDomain.NewMessageError(errDomain.CodeTooShort, "name", "tag.name.too_short", nameLengthMin)
+	}
+
+	// 日付のバリデーション(lastUpdateAtのほうが後か)
+	if lastUpdateAt.Before(createAt) {
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "lastUpdateAt", "update_time.before_create")
+	}
+
+	// 削除フラグが立ってない もしくは 削除日は作成日よりも後であるか
+	if deletedAt != nil && deletedAt.Before(createAt) {
+		return nil, errDomain.NewMessageError(errDomain.CodeOutOfRange, "deletedAt", "delete_time.before_create")
+	}
+
+	return &Tag{
+		id:           id,
+		name:         name,
+		createAt:     createAt,
+		lastUpdateAt: lastUpdateAt,
+		deletedAt:    deletedAt,
+	}, nil
+}
+
+func Reconstruct(
+	id string,
+	name string,
+	createAt time.Time,
+	lastUpdateAt time.Time,
+	deletedAt *time.Time,
+) (*Tag, error) {
+	return newTag(id, name, createAt, lastUpdateAt, deletedAt)
+}
+
+func NewTag(
+	clk clock.Clock,
+	name string,
+) (*Tag, error) {
+	now := clk.Now()
+	return newTag(ulid.NewULID(), name, now, now, nil)
+}
+
+func (t *Tag) ID() string {
+	return t.id
+}
+
+func (t *Tag) Name() string {
+	return t.name
+}
+
+func (t *Tag) CreateAt() time.Time {
+	return t.createAt
+}
+
+func (t *Tag) LastUpdateAt() time.Time {
+	return t.lastUpdateAt
+}
+
+func (t *Tag) DeletedAt() *time.Time {
+	return t.deletedAt
+}
+
+// Rename は名前を変更する
+func (t *Tag) Rename(clk clock.Clock, name string) error {
+	return t.update(clk, func(next *Tag) {
+		next.name = name
+	})
+}
+
+func (t *Tag) IsDeleted() bool {
+	return t.deletedAt != nil
+}
+
+// Delete は論理削除する
+func (t *Tag) Delete(clk clock.Clock) error {
+	if t.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "already_deleted")
+	}
+	now := clk.Now()
+	return t.updateAt(now, func(next *Tag) {
+		next.deletedAt = &now
+	})
+}
+
+// Restore は論理削除を取り消す
+func (t *Tag) Restore(clk clock.Clock) error {
+	if !t.IsDeleted() {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "", "not_deleted")
+	}
+	return t.update(clk, func(next *Tag) {
+		next.deletedAt = nil
+	})
+}
+
+// update は変更を加えたタグをコンストラクタと同じバリデーションにかけ、
+// 問題がなければ更新日を現在時刻にして反映する
+func (t *Tag) update(clk clock.Clock, change func(next *Tag)) error {
+	return t.updateAt(clk.Now(), change)
+}
+
+// updateAt は更新日を now にして反映する。削除日など他の日時と揃える場合に使う
+func (t *Tag) updateAt(now time.Time, change func(next *Tag)) error {
+	next := *t
+	change(&next)
+	updated, err := newTag(next.id, next.name, next.createAt, now, next.deletedAt)
+	if err != nil {
+		return err
+	}
+	*t = *updated
+	return nil
+}
@@ -0,0 +1,169 @@
+package tag
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+func Test_newTag(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-1 * time.Hour)
+	later := now.Add(1 * time.Hour)
+	type args struct {
+		name         string
+		createAt     time.Time
+		lastUpdateAt time.Time
+		deletedAt    *time.Time
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		want       *Tag
+		wantErr    bool
+		wantErrStr string
+	}{
+		{
+			name: "正常系",
+			args: args{
+				name:         "ミステリー",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			want: &Tag{
+				name:         "ミステリー",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    nil,
+			},
+			wantErr:    false,
+			wantErrStr: "",
+		},
+		{
+			name: "異常系: nameが不正",
+			args: args{
+				name:         "",
+				createAt:     now,
+				lastUpdateAt: later,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: fmt.Sprintf("タグ名は%d文字以上である必要があります", nameLengthMin),
+		},
+		{
+			name: "異常系: 更新日が不正",
+			args: args{
+				name:         "ミステリー",
+				createAt:     now,
+				lastUpdateAt: earlier,
+				deletedAt:    nil,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "更新日は作成日よりも後である必要があります",
+		},
+		{
+			name: "異常系: 削除日が不正",
+			args: args{
+				name:         "ミステリー",
+				createAt:     now,
+				lastUpdateAt: now,
+				deletedAt:    &earlier,
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrStr: "削除日は作成日よりも後である必要があります",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newTag(ulid.NewULID(), tt.args.name, tt.args.createAt, tt.args.lastUpdateAt, tt.args.deletedAt)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.Error() != tt.wantErrStr {
+				if diff := cmp.Diff(err.Error(), tt.wantErrStr); diff != "" {
+					t.Errorf("got: %v, want: %s.\n error is %s", err.Error(), tt.wantErrStr, diff)
+				}
+			}
+			diff := cmp.Diff(
+				got, tt.want,
+				cmp.AllowUnexported(Tag{}),
+				cmpopts.IgnoreFields(Tag{}, "id"),
+			)
+
+			if diff != "" {
+				t.Errorf("newTag() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestNewTag(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	got, err := NewTag(clk, "ミステリー")
+	if err != nil {
+		t.Fatalf("NewTag() error = %v", err)
+	}
+	if !ulid.IsValid(got.ID()) {
+		t.Errorf("ID() = %s, want ULID", got.ID())
+	}
+	if !got.CreateAt().Equal(now) || !got.LastUpdateAt().Equal(now) {
+		t.Errorf("CreateAt() = %v, LastUpdateAt() = %v, want = %v", got.CreateAt(), got.LastUpdateAt(), now)
+	}
+	if got.IsDeleted() {
+		t.Errorf("IsDeleted() = true, want = false")
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	now := time.Now()
+	_, err := Reconstruct("id", "ミステリー", now, now, nil)
+	if err == nil || err.Error() != "タグIDが不正です" {
+		t.Errorf("Reconstruct() error = %v, want タグIDが不正です", err)
+	}
+}
+
+func TestTag_DeleteRestore(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	v := func() *Tag {
+		v, err := NewTag(clock.NewFixed(now), "ミステリー")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}()
+
+	if err := v.Restore(clk); err == nil || err.Error() != "削除されていません" {
+		t.Errorf("Restore() error = %v, want 削除されていません", err)
+	}
+
+	clk.Advance(1 * time.Hour)
+	if err := v.Delete(clk); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !v.IsDeleted() || !v.DeletedAt().Equal(clk.Now()) || !v.LastUpdateAt().Equal(clk.Now()) {
+		t.Errorf("DeletedAt() = %v, LastUpdateAt() = %v, want = %v", v.DeletedAt(), v.LastUpdateAt(), clk.Now())
+	}
+	if err := v.Delete(clk); err == nil || err.Error() != "既に削除されています" {
+		t.Errorf("Delete() error = %v, want 既に削除されています", err)
+	}
+
+	clk.Advance(1 * time.Hour)
+	if err := v.Restore(clk); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if v.IsDeleted() || !v.LastUpdateAt().Equal(clk.Now()) {
+		t.Errorf("DeletedAt() = %v, LastUpdateAt() = %v, want = nil, %v", v.DeletedAt(), v.LastUpdateAt(), clk.Now())
+	}
+}
@@ -0,0 +1,157 @@
+package barcode
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"sort"
+)
+
+const (
+	// scanLines は縦横それぞれ何本の線を走査するか
+	scanLines = 64
+	// minContrast は白黒を区別できるとみなす輝度差
+	minContrast = 32
+)
+
+var ErrNotFound = errors.New("バーコードが見つかりません")
+
+// Decode はJPEGまたはPNGの画像からEAN-13のバーコードを読み取る
+func Decode(r io.Reader) ([]string, error) {
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeImage(img)
+}
+
+// DecodeImage は画像を縦横に走査し、読み取れたEAN-13を読み取れた回数の多い順に返す
+// 横向きや逆さまに撮影した画像も読み取れる
+func DecodeImage(img image.Image) ([]string, error) {
+	b := img.Bounds()
+	counts := map[string]int{}
+	var codes []string
+	record := func(line []uint8) {
+		for _, code := range decodeLine(line) {
+			if counts[code] == 0 {
+				codes = append(codes, code)
+			}
+			counts[code]++
+		}
+	}
+
+	for _, y := range samples(b.Min.Y, b.Max.Y) {
+		line := make([]uint8, 0, b.Dx())
+		for x := b.Min.X; x < b.Max.X; x++ {
+			line = append(line, luminance(img.At(x, y)))
+		}
+		record(line)
+	}
+	for _, x := range samples(b.Min.X, b.Max.X) {
+		line := make([]uint8, 0, b.Dy())
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			line = append(line, luminance(img.At(x, y)))
+		}
+		record(line)
+	}
+
+	if len(codes) == 0 {
+		return nil, ErrNotFound
+	}
+	sort.SliceStable(codes, func(i, j int) bool {
+		return counts[codes[i]] > counts[codes[j]]
+	})
+	return codes, nil
+}
+
+// samples は min から max の間で走査する位置を返す
+func samples(min, max int) []int {
+	step := (max - min) / scanLines
+	if step < 1 {
+		step = 1
+	}
+	var positions []int
+	for p := min + step/2; p < max; p += step {
+		positions = append(positions, p)
+	}
+	return positions
+}
+
+func luminance(c color.Color) uint8 {
+	return color.GrayModel.Convert(c).(color.Gray).Y
+}
+
+// decodeLine は1本の走査線を両方向から読み取る
+func decodeLine(line []uint8) []string {
+	var codes []string
+	for _, dark := range binarize(line) {
+		widths, firstDark := runs(dark)
+		codes = append(codes, decodeRuns(widths, firstDark)...)
+
+		reversed := make([]int, len(widths))
+		for i, w := range widths {
+			reversed[len(widths)-1-i] = w
+		}
+		lastDark := firstDark == (len(widths)%2 == 1)
+		codes = append(codes, decodeRuns(reversed, lastDark)...)
+	}
+	return codes
+}
+
+// binarize は走査線全体の中間値と周囲の平均値の2通りで白黒に分ける
+// 周囲の平均値は照明のむらがある写真のために使う
+func binarize(line []uint8) [][]bool {
+	if len(line) == 0 {
+		return nil
+	}
+	lo, hi := line[0], line[0]
+	for _, v := range line {
+		lo, hi = min(lo, v), max(hi, v)
+	}
+	if int(hi)-int(lo) < minContrast {
+		return nil
+	}
+
+	mid := (int(lo) + int(hi)) / 2
+	global := make([]bool, len(line))
+	for i, v := range line {
+		global[i] = int(v) < mid
+	}
+
+	// 累積和で周囲の平均を求める
+	sums := make([]int, len(line)+1)
+	for i, v := range line {
+		sums[i+1] = sums[i] + int(v)
+	}
+	radius := max(len(line)/16, 8)
+	local := make([]bool, len(line))
+	for i, v := range line {
+		from, to := max(i-radius, 0), min(i+radius+1, len(line))
+		mean := (sums[to] - sums[from]) / (to - from)
+		// 無地の部分のノイズを黒と判定しないように余裕を持たせる
+		local[i] = int(v) < mean-minContrast/4
+	}
+	return [][]bool{global, local}
+}
+
+// runs は白黒の並びを同じ色が続く幅に変換する
+func runs(dark []bool) ([]int, bool) {
+	if len(dark) == 0 {
+		return nil, false
+	}
+	var widths []int
+	width := 1
+	for i := 1; i < len(dark); i++ {
+		if dark[i] == dark[i-1] {
+			width++
+			continue
+		}
+		widths = append(widths, width)
+		width = 1
+	}
+	widths = append(widths, width)
+	return widths, dark[0]
+}
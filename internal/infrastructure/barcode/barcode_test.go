@@ -0,0 +1,170 @@
+package barcode
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testISBN      = "9784758079211"
+	testPriceCode = "1920079006804"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		img     image.Image
+		encode  func(*bytes.Buffer, image.Image) error
+		want    []string
+		wantErr error
+	}{
+		{
+			name:   "正常系: PNG",
+			img:    renderBackCover(3, 0),
+			encode: func(b *bytes.Buffer, img image.Image) error { return png.Encode(b, img) },
+			want:   []string{testISBN, testPriceCode},
+		},
+		{
+			name:   "正常系: JPEG",
+			img:    renderBackCover(2, 0),
+			encode: func(b *bytes.Buffer, img image.Image) error { return jpeg.Encode(b, img, &jpeg.Options{Quality: 70}) },
+			want:   []string{testISBN, testPriceCode},
+		},
+		{
+			name:   "正常系: 横向き",
+			img:    renderBackCover(3, 1),
+			encode: func(b *bytes.Buffer, img image.Image) error { return png.Encode(b, img) },
+			want:   []string{testISBN, testPriceCode},
+		},
+		{
+			name:   "正常系: 逆さま",
+			img:    renderBackCover(3, 2),
+			encode: func(b *bytes.Buffer, img image.Image) error { return png.Encode(b, img) },
+			want:   []string{testISBN, testPriceCode},
+		},
+		{
+			name:    "異常系: バーコードがない",
+			img:     image.NewGray(image.Rect(0, 0, 200, 100)),
+			encode:  func(b *bytes.Buffer, img image.Image) error { return png.Encode(b, img) },
+			wantErr: ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.encode(&buf, tt.img); err != nil {
+				t.Fatal(err)
+			}
+			got, err := Decode(&buf)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(sorted(got), tt.want); diff != "" {
+				t.Errorf("Decode() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+// renderBackCover は裏表紙のように2段のバーコードを横に並べた画像を作る
+// rotation は時計回りに90度回転する回数
+func renderBackCover(moduleWidth, rotation int) image.Image {
+	isbn := encodeEAN13(testISBN)
+	price := encodeEAN13(testPriceCode)
+	const quiet, barHeight, margin = 15, 60, 20
+
+	width := (quiet*3 + len(isbn) + len(price)) * moduleWidth
+	img := image.NewGray(image.Rect(0, 0, width, barHeight+margin*2))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	x := quiet * moduleWidth
+	for _, bits := range []string{isbn, price} {
+		for _, bit := range bits {
+			if bit == '1' {
+				draw.Draw(img, image.Rect(x, margin, x+moduleWidth, margin+barHeight), image.NewUniform(color.Black), image.Point{}, draw.Src)
+			}
+			x += moduleWidth
+		}
+		x += quiet * moduleWidth
+	}
+
+	var rotated image.Image = img
+	for i := 0; i < rotation; i++ {
+		rotated = rotate90(rotated)
+	}
+	return rotated
+}
+
+func rotate90(src image.Image) image.Image {
+	b := src.Bounds()
+	dst := image.NewGray(image.Rect(0, 0, b.Dy(), b.Dx()))
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			dst.Set(b.Max.Y-1-y, x, src.At(x, y))
+		}
+	}
+	return dst
+}
+
+// encodeEAN13 はEAN-13をモジュールごとの0と1に変換する
+func encodeEAN13(code string) string {
+	var parity string
+	for p, d := range firstDigitParity {
+		if d == code[0] {
+			parity = p
+		}
+	}
+
+	bits := "101"
+	for k := 0; k < 6; k++ {
+		runs := digitRuns[code[1+k]-'0']
+		if parity[k] == 'G' {
+			runs = [4]int{runs[3], runs[2], runs[1], runs[0]}
+		}
+		bits += runsToBits(runs, '0')
+	}
+	bits += "01010"
+	for k := 0; k < 6; k++ {
+		bits += runsToBits(digitRuns[code[7+k]-'0'], '1')
+	}
+	return bits + "101"
+}
+
+func runsToBits(runs [4]int, first byte) string {
+	var bits []byte
+	bit := first
+	for _, r := range runs {
+		for i := 0; i < r; i++ {
+			bits = append(bits, bit)
+		}
+		bit = '0' + '1' - bit
+	}
+	return string(bits)
+}
+
+// sorted は読み取り回数によらず比較できるように降順に並べる
+func sorted(codes []string) []string {
+	if codes == nil {
+		return nil
+	}
+	out := slices.Clone(codes)
+	slices.Sort(out)
+	slices.Reverse(out)
+	return out
+}
+
+// Test_encodeEAN13 は符号表をGS1の例と照合する
+func Test_encodeEAN13(t *testing.T) {
+	want := "10100010110100111011001100100110111101001110101010110011011011001000010101110010011101000100101"
+	if got := encodeEAN13("5901234123457"); got != want {
+		t.Errorf("encodeEAN13() = %v, want = %v", got, want)
+	}
+}
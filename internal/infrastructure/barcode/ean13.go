@@ -0,0 +1,163 @@
+package barcode
+
+import "github.com/mitsu-yuki/shisho-backend/pkg/checkdigit"
+
+const (
+	// EAN-13はガード3本 + 左6桁 + 中央ガード5本 + 右6桁 + ガード3本の59本のバーとスペースからなる
+	ean13Runs    = 59
+	ean13Modules = 95
+	digitModules = 7
+	// maxDigitError は1桁あたりに許すモジュール幅のずれの合計
+	maxDigitError = 1.5
+	// minQuietZone は左右の余白として必要なモジュール数
+	minQuietZone = 3
+)
+
+// digitRuns は各数字のLコードのバーとスペースの幅
+// Rコードは白黒を反転しただけなので同じ幅になり、GコードはRコードを左右反転した幅になる
+var digitRuns = [10][4]int{
+	{3, 2, 1, 1},
+	{2, 2, 2, 1},
+	{2, 1, 2, 2},
+	{1, 4, 1, 1},
+	{1, 1, 3, 2},
+	{1, 2, 3, 1},
+	{1, 1, 1, 4},
+	{1, 3, 1, 2},
+	{1, 2, 1, 3},
+	{3, 1, 1, 2},
+}
+
+// firstDigitParity は左6桁のL/Gの並びから1桁目を決める表
+var firstDigitParity = map[string]byte{
+	"LLLLLL": '0',
+	"LLGLGG": '1',
+	"LLGGLG": '2',
+	"LLGGGL": '3',
+	"LGLLGG": '4',
+	"LGGLLG": '5',
+	"LGGGLL": '6',
+	"LGLGLG": '7',
+	"LGLGGL": '8',
+	"LGGLGL": '9',
+}
+
+// decodeRuns は先頭が黒かどうかとバーとスペースの幅の並びからEAN-13を探す
+func decodeRuns(widths []int, firstDark bool) []string {
+	var codes []string
+	for i := 0; i+ean13Runs <= len(widths); i++ {
+		dark := (i%2 == 0) == firstDark
+		if !dark {
+			continue
+		}
+		if code, ok := decodeAt(widths, i); ok {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+func decodeAt(widths []int, start int) (string, bool) {
+	w := widths[start : start+ean13Runs]
+	total := 0
+	for _, v := range w {
+		total += v
+	}
+	module := float64(total) / ean13Modules
+
+	// 左右の余白は画像の端であれば省略されているとみなす
+	if start > 0 && float64(widths[start-1]) < module*minQuietZone {
+		return "", false
+	}
+	if end := start + ean13Runs; end < len(widths) && float64(widths[end]) < module*minQuietZone {
+		return "", false
+	}
+	if !isGuard(w[0:3], module) || !isGuard(w[27:32], module) || !isGuard(w[56:59], module) {
+		return "", false
+	}
+
+	code := make([]byte, 13)
+	parity := make([]byte, 6)
+	for k := 0; k < 6; k++ {
+		d, reversed, ok := matchDigit(w[3+4*k:7+4*k], true)
+		if !ok {
+			return "", false
+		}
+		code[1+k] = d
+		parity[k] = 'L'
+		if reversed {
+			parity[k] = 'G'
+		}
+	}
+	for k := 0; k < 6; k++ {
+		d, _, ok := matchDigit(w[32+4*k:36+4*k], false)
+		if !ok {
+			return "", false
+		}
+		code[7+k] = d
+	}
+	first, ok := firstDigitParity[string(parity)]
+	if !ok {
+		return "", false
+	}
+	code[0] = first
+
+	if !checkdigit.JAN13IsValid(string(code)) {
+		return "", false
+	}
+	return string(code), true
+}
+
+// isGuard はガードのバーとスペースがすべて1モジュール幅か調べる
+func isGuard(w []int, module float64) bool {
+	for _, v := range w {
+		ratio := float64(v) / module
+		if ratio < 0.4 || ratio > 1.6 {
+			return false
+		}
+	}
+	return true
+}
+
+// matchDigit は4本の幅に最も近い数字を返す
+// allowReversed が true の場合はGコードも候補にし、Gコードだったかを返す
+func matchDigit(w []int, allowReversed bool) (byte, bool, bool) {
+	sum := w[0] + w[1] + w[2] + w[3]
+	if sum == 0 {
+		return 0, false, false
+	}
+	scale := float64(digitModules) / float64(sum)
+
+	best, bestReversed, bestErr := -1, false, maxDigitError
+	for d, runs := range digitRuns {
+		if e := runError(w, runs, scale, false); e < bestErr {
+			best, bestReversed, bestErr = d, false, e
+		}
+		if !allowReversed {
+			continue
+		}
+		if e := runError(w, runs, scale, true); e < bestErr {
+			best, bestReversed, bestErr = d, true, e
+		}
+	}
+	if best < 0 {
+		return 0, false, false
+	}
+	return byte('0' + best), bestReversed, true
+}
+
+func runError(w []int, runs [4]int, scale float64, reversed bool) float64 {
+	e := 0.0
+	for j := range w {
+		want := runs[j]
+		if reversed {
+			want = runs[len(runs)-1-j]
+		}
+		diff := float64(w[j])*scale - float64(want)
+		if diff < 0 {
+			diff = -diff
+		}
+		e += diff
+	}
+	return e
+}
@@ -0,0 +1,52 @@
+package mecab
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/reading"
+	"github.com/mitsu-yuki/shisho-backend/pkg/text"
+)
+
+// Suggester はローカルにインストールしたMeCabで読みを推定する
+// MeCabの -Oyomi で出力したカタカナの読みを候補にする
+type Suggester struct {
+	path string
+}
+
+var _ reading.Suggester = (*Suggester)(nil)
+
+// NewSuggester は path にあるMeCabのコマンドを使う
+func NewSuggester(path string) *Suggester {
+	return &Suggester{path: path}
+}
+
+func (s *Suggester) Suggest(ctx context.Context, name string) ([]string, error) {
+	// 空白は読みの区切りとして残すため、区切られた部分ごとに解析する
+	parts := strings.Fields(name)
+	if len(parts) == 0 {
+		return nil, nil
+	}
+	cmd := exec.CommandContext(ctx, s.path, "-Oyomi")
+	cmd.Stdin = strings.NewReader(strings.Join(parts, "\n") + "\n")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("mecab: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	lines := strings.Split(strings.TrimRight(string(out), "\n"), "\n")
+	if len(lines) != len(parts) {
+		return nil, fmt.Errorf("mecab: unexpected output lines %d, want %d", len(lines), len(parts))
+	}
+	// 辞書にない語は表記のまま出力されるため、カタカナにならなければ候補にしない
+	suggestion := text.NormalizeReading(strings.Join(lines, " "))
+	if !text.IsReading(suggestion) {
+		return nil, nil
+	}
+	return []string{suggestion}, nil
+}
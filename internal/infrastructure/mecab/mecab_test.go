@@ -0,0 +1,68 @@
+package mecab
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeMeCab は入力の行ごとに決まった読みを返すスクリプトを作る
+func fakeMeCab(t *testing.T, script string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "mecab")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestSuggester_Suggest(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		s       string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "正常系",
+			script: `[ "$1" = "-Oyomi" ] || exit 1; cat >/dev/null; echo ナツメソウセキ`,
+			s:      "夏目漱石",
+			want:   []string{"ナツメソウセキ"},
+		},
+		{
+			name: "正常系: 空白で区切る",
+			script: `while read -r line; do
+  case "$line" in 夏目) echo ナツメ ;; 漱石) echo ソウセキ ;; esac
+done`,
+			s:    "夏目 漱石",
+			want: []string{"ナツメ　ソウセキ"},
+		},
+		{
+			name:   "正常系: 読みにならない",
+			script: `cat`,
+			s:      "KADOKAWA",
+			want:   nil,
+		},
+		{
+			name:    "異常系: コマンドが失敗",
+			script:  `echo "dictionary not found" >&2; exit 1`,
+			s:       "夏目漱石",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSuggester(fakeMeCab(t, tt.script)).Suggest(context.Background(), tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Suggest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Suggest() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/author"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/pkg/gojuon"
+	"github.com/mitsu-yuki/shisho-backend/pkg/romaji"
+)
+
+type authorRepository struct {
+	db *sql.DB
+}
+
+func NewAuthorRepository(db *sql.DB) author.AuthorRepository {
+	return &authorRepository{db: db}
+}
+
+const authorColumns = `"id", "creator_name", "creator_name_phonic", "creator_add_time", "creator_update_time", "creator_delete_time", "creator_canonical_id"`
+
+func (r *authorRepository) Save(ctx context.Context, a *author.Author) error {
+	if err := r.checkAliases(ctx, a); err != nil {
+		return err
+	}
+	_, err := executor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO "creator" (`+authorColumns+`, "creator_name_romaji")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT ("id") DO UPDATE SET
+			"creator_name" = EXCLUDED."creator_name",
+			"creator_name_phonic" = EXCLUDED."creator_name_phonic",
+			"creator_update_time" = EXCLUDED."creator_update_time",
+			"creator_delete_time" = EXCLUDED."creator_delete_time",
+			"creator_canonical_id" = EXCLUDED."creator_canonical_id",
+			"creator_name_romaji" = EXCLUDED."creator_name_romaji"`,
+		a.ID(), a.Name(), a.NamePhonic(), a.CreateAt(), a.LastUpdateAt(), a.DeletedAt(), a.CanonicalID(), a.NameRomaji(),
+	)
+	return err
+}
+
+// checkAliases は別名として保存する著者が、自身の別名を持っていないか調べる
+func (r *authorRepository) checkAliases(ctx context.Context, a *author.Author) error {
+	if !a.IsAlias() {
+		return nil
+	}
+	var id string
+	err := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT "id" FROM "creator" WHERE "creator_canonical_id" = $1 LIMIT 1`,
+		a.ID(),
+	).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return author.ErrHasAliases
+}
+
+func (r *authorRepository) FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*author.Author, error) {
+	row := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+authorColumns+` FROM "creator" WHERE "id" = $1 AND `+notDeleted(`"creator_delete_time"`, opts),
+		id,
+	)
+	a, err := scanAuthor(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errDomain.ErrNotFound
+	}
+	return a, err
+}
+
+func (r *authorRepository) FindAll(ctx context.Context, opts ...repository.FindOption) ([]*author.Author, error) {
+	return r.query(ctx,
+		`SELECT `+authorColumns+` FROM "creator" WHERE `+notDeleted(`"creator_delete_time"`, opts)+` ORDER BY "id"`,
+	)
+}
+
+func (r *authorRepository) query(ctx context.Context, query string, args ...any) ([]*author.Author, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var authors []*author.Author
+	for rows.Next() {
+		a, err := scanAuthor(rows)
+		if err != nil {
+			return nil, err
+		}
+		authors = append(authors, a)
+	}
+	return authors, rows.Err()
+}
+
+// FindAllOrderByReading はDBの照合順序によらず五十音順になるようにアプリケーション側で並べる
+func (r *authorRepository) FindAllOrderByReading(ctx context.Context, opts ...repository.FindOption) ([]*author.Author, error) {
+	authors, err := r.FindAll(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	gojuon.Sort(authors, (*author.Author).NamePhonic)
+	return authors, nil
+}
+
+func (r *authorRepository) FindByIndexRow(ctx context.Context, row gojuon.Row, opts ...repository.FindOption) ([]*author.Author, error) {
+	authors, err := r.FindAllOrderByReading(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(authors, func(a *author.Author) bool {
+		return gojuon.RowOf(a.NamePhonic()) != row
+	}), nil
+}
+
+// FindByRomaji は区切りを除いたローマ字の部分一致で探し、五十音順に並べる
+// 区切りや記号だけの q はすべてに一致してしまうため、何も返さない
+func (r *authorRepository) FindByRomaji(ctx context.Context, q string, opts ...repository.FindOption) ([]*author.Author, error) {
+	query := romaji.NormalizeQuery(q)
+	if query == "" {
+		return nil, nil
+	}
+	authors, err := r.query(ctx,
+		`SELECT `+authorColumns+` FROM "creator"
+		WHERE REPLACE("creator_name_romaji", ' ', '') LIKE '%' || $1 || '%' AND `+notDeleted(`"creator_delete_time"`, opts),
+		query,
+	)
+	if err != nil {
+		return nil, err
+	}
+	gojuon.Sort(authors, (*author.Author).NamePhonic)
+	return authors, nil
+}
+
+func (r *authorRepository) FindBySlug(ctx context.Context, slug string, opts ...repository.FindOption) ([]*author.Author, error) {
+	return r.query(ctx,
+		`SELECT `+authorColumns+` FROM "creator" WHERE "creator_name_romaji" = $1 AND `+notDeleted(`"creator_delete_time"`, opts)+` ORDER BY "id"`,
+		romaji.FromSlug(slug),
+	)
+}
+
+func (r *authorRepository) FindAliases(ctx context.Context, canonicalID string, opts ...repository.FindOption) ([]*author.Author, error) {
+	authors, err := r.query(ctx,
+		`SELECT `+authorColumns+` FROM "creator" WHERE "creator_canonical_id" = $1 AND `+notDeleted(`"creator_delete_time"`, opts),
+		canonicalID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	gojuon.Sort(authors, (*author.Author).NamePhonic)
+	return authors, nil
+}
+
+func (r *authorRepository) SaveMerge(ctx context.Context, duplicate *author.Author, record author.MergeRecord) error {
+	return runInTx(ctx, r.db, func(ctx context.Context) error {
+		db := executor(ctx, r.db)
+
+		// 統合元の別名は統合先の別名にする。統合元を別名として保存する前に付け替える
+		if _, err := db.ExecContext(ctx, `
+			UPDATE "creator" SET "creator_canonical_id" = $2, "creator_update_time" = $3
+			WHERE "creator_canonical_id" = $1`,
+			record.DuplicateID(), record.CanonicalID(), record.MergedAt(),
+		); err != nil {
+			return err
+		}
+		if err := r.Save(ctx, duplicate); err != nil {
+			return err
+		}
+
+		// 統合先が同じ書籍に同じ役割で載っている場合は、付け替えると重複するため統合元のクレジットを消す
+		if _, err := db.ExecContext(ctx, `
+			DELETE FROM "author_list" AS "duplicate"
+			WHERE "duplicate"."creator_id" = $1
+				AND EXISTS (
+					SELECT 1 FROM "author_list" AS "canonical"
+					WHERE "canonical"."book_id" = "duplicate"."book_id"
+						AND "canonical"."creator_id" = $2
+						AND "canonical"."author_list_role" = "duplicate"."author_list_role"
+				)`,
+			record.DuplicateID(), record.CanonicalID(),
+		); err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, `
+			UPDATE "author_list" SET "creator_id" = $2, "author_list_update_time" = $3
+			WHERE "creator_id" = $1`,
+			record.DuplicateID(), record.CanonicalID(), record.MergedAt(),
+		); err != nil {
+			return err
+		}
+
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO "creator_merge" ("id", "duplicate_creator_id", "canonical_creator_id", "creator_merge_add_time")
+			VALUES ($1, $2, $3, $4)`,
+			record.ID(), record.DuplicateID(), record.CanonicalID(), record.MergedAt(),
+		)
+		return err
+	})
+}
+
+func (r *authorRepository) FindMergeRecords(ctx context.Context, authorID string) ([]author.MergeRecord, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx, `
+		SELECT "id", "duplicate_creator_id", "canonical_creator_id", "creator_merge_add_time" FROM "creator_merge"
+		WHERE "duplicate_creator_id" = $1 OR "canonical_creator_id" = $1
+		ORDER BY "creator_merge_add_time", "id"`,
+		authorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []author.MergeRecord
+	for rows.Next() {
+		var id, duplicateID, canonicalID string
+		var mergedAt time.Time
+		if err := rows.Scan(&id, &duplicateID, &canonicalID, &mergedAt); err != nil {
+			return nil, err
+		}
+		records = append(records, author.ReconstructMergeRecord(id, duplicateID, canonicalID, mergedAt))
+	}
+	return records, rows.Err()
+}
+
+func (r *authorRepository) Delete(ctx context.Context, id string) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx, `DELETE FROM "creator" WHERE "id" = $1`, id)
+	return err
+}
+
+// Purge は書籍からも別名からも参照されていない著者のみ削除する
+func (r *authorRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	result, err := executor(ctx, r.db).ExecContext(ctx, `
+		DELETE FROM "creator"
+		WHERE "creator_delete_time" < $1
+			AND NOT EXISTS (SELECT 1 FROM "author_list" WHERE "author_list"."creator_id" = "creator"."id")
+			AND NOT EXISTS (SELECT 1 FROM "creator" AS "alias" WHERE "alias"."creator_canonical_id" = "creator"."id")`,
+		before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func scanAuthor(s scanner) (*author.Author, error) {
+	var (
+		id, name, namePhonic string
+		createAt             time.Time
+		lastUpdateAt         sql.NullTime
+		deletedAt            sql.NullTime
+		canonicalID          sql.NullString
+	)
+	if err := s.Scan(&id, &name, &namePhonic, &createAt, &lastUpdateAt, &deletedAt, &canonicalID); err != nil {
+		return nil, err
+	}
+	return author.Reconstruct(id, name, namePhonic, nullStringToPtr(canonicalID), createAt, updateTime(createAt, lastUpdateAt), nullTimeToPtr(deletedAt))
+}
@@ -0,0 +1,379 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/author"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/gojuon"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+func TestAuthorRepository_FindByID(t *testing.T) {
+	id := ulid.NewULID()
+	now := time.Now()
+	columns := []string{"id", "creator_name", "creator_name_phonic", "creator_add_time", "creator_update_time", "creator_delete_time", "creator_canonical_id"}
+	tests := []struct {
+		name       string
+		opts       []repository.FindOption
+		query      string
+		rows       *sqlmock.Rows
+		want       *author.Author
+		wantErr    error
+		wantErrStr string
+	}{
+		{
+			name:  "正常系",
+			query: `SELECT .* FROM "creator" WHERE "id" = \$1 AND "creator_delete_time" IS NULL`,
+			rows:  sqlmock.NewRows(columns).AddRow(id, "著者", "チョシャ", now, now, nil, nil),
+			want:  mustAuthor(t, id, "著者", "チョシャ", now, now),
+		},
+		{
+			name:  "正常系: 更新日がNULL",
+			query: `SELECT .* FROM "creator" WHERE "id" = \$1 AND "creator_delete_time" IS NULL`,
+			rows:  sqlmock.NewRows(columns).AddRow(id, "著者", "チョシャ", now, nil, nil, nil),
+			want:  mustAuthor(t, id, "著者", "チョシャ", now, now),
+		},
+		{
+			name:  "正常系: 削除済みを含める",
+			opts:  []repository.FindOption{repository.WithDeleted()},
+			query: `SELECT .* FROM "creator" WHERE "id" = \$1 AND TRUE`,
+			rows:  sqlmock.NewRows(columns).AddRow(id, "著者", "チョシャ", now, now, now, nil),
+			want:  mustDeletedAuthor(t, id, "著者", "チョシャ", now),
+		},
+		{
+			name:    "異常系: 存在しない",
+			query:   `SELECT .* FROM "creator" WHERE "id" = \$1`,
+			rows:    sqlmock.NewRows(columns),
+			wantErr: errDomain.ErrNotFound,
+		},
+		{
+			name:       "異常系: 読みが不正",
+			query:      `SELECT .* FROM "creator" WHERE "id" = \$1`,
+			rows:       sqlmock.NewRows(columns).AddRow(id, "著者", "著者", now, now, nil, nil),
+			wantErrStr: "著者名読みはカタカナである必要があります",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			mock.ExpectQuery(tt.query).WithArgs(id).WillReturnRows(tt.rows)
+
+			got, err := NewAuthorRepository(db).FindByID(context.Background(), id, tt.opts...)
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("FindByID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrStr != "" && (err == nil || err.Error() != tt.wantErrStr) {
+				t.Errorf("FindByID() error = %v, wantErrStr %s", err, tt.wantErrStr)
+			}
+			if diff := cmp.Diff(got, tt.want, cmp.AllowUnexported(author.Author{})); diff != "" {
+				t.Errorf("FindByID() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAuthorRepository_Save(t *testing.T) {
+	now := time.Now()
+	a, err := author.NewAuthor(clock.NewFixed(now), "著者", "チョシャ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectExec(`INSERT INTO "creator"`).
+		WithArgs(a.ID(), "著者", "チョシャ", now, now, nil, nil, "chosha").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := NewAuthorRepository(db).Save(context.Background(), a); err != nil {
+		t.Errorf("Save() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAuthorRepository_Save_HasAliases(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	a, err := author.NewAuthor(clk, "尾田栄一郎", "オダ エイイチロウ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	canonical, err := author.NewAuthor(clk, "尾田 栄一郎", "オダ エイイチロウ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.SetCanonical(clk, canonical); err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`SELECT "id" FROM "creator" WHERE "creator_canonical_id" = \$1`).
+		WithArgs(a.ID()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ulid.NewULID()))
+
+	if err := NewAuthorRepository(db).Save(context.Background(), a); !errors.Is(err, author.ErrHasAliases) {
+		t.Errorf("Save() error = %v, want %v", err, author.ErrHasAliases)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAuthorRepository_FindByIndexRow(t *testing.T) {
+	now := time.Now()
+	columns := []string{"id", "creator_name", "creator_name_phonic", "creator_add_time", "creator_update_time", "creator_delete_time", "creator_canonical_id"}
+	tests := []struct {
+		name string
+		row  gojuon.Row
+		want []string
+	}{
+		{name: "正常系: か行", row: gojuon.RowKa, want: []string{"カキ", "ケーキ", "ゲーテ"}},
+		{name: "正常系: さ行", row: gojuon.RowSa, want: []string{"ショウジ", "ジョージ・オーウェル"}},
+		{name: "正常系: 該当なし", row: gojuon.RowMa, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			mock.ExpectQuery(`SELECT .* FROM "creator" WHERE "creator_delete_time" IS NULL ORDER BY "id"`).WillReturnRows(
+				sqlmock.NewRows(columns).
+					AddRow(ulid.NewULID(), "ゲーテ", "ゲーテ", now, now, nil, nil).
+					AddRow(ulid.NewULID(), "ジョージ・オーウェル", "ジョージ・オーウェル", now, now, nil, nil).
+					AddRow(ulid.NewULID(), "東海林", "ショウジ", now, now, nil, nil).
+					AddRow(ulid.NewULID(), "ケーキ", "ケーキ", now, now, nil, nil).
+					AddRow(ulid.NewULID(), "柿", "カキ", now, now, nil, nil),
+			)
+
+			got, err := NewAuthorRepository(db).FindByIndexRow(context.Background(), tt.row)
+			if err != nil {
+				t.Fatalf("FindByIndexRow() error = %v", err)
+			}
+			phonics := []string{}
+			for _, a := range got {
+				phonics = append(phonics, a.NamePhonic())
+			}
+			if diff := cmp.Diff(phonics, tt.want); diff != "" {
+				t.Errorf("FindByIndexRow() = %v, want = %v.\n error is %s", phonics, tt.want, diff)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAuthorRepository_FindByRomaji_Empty(t *testing.T) {
+	// 区切りや記号だけの q は LIKE '%%' になりすべてに一致するため、問い合わせずに空を返す
+	tests := []struct {
+		name string
+		q    string
+	}{
+		{name: "正常系: 空文字", q: ""},
+		{name: "正常系: 空白のみ", q: " "},
+		{name: "正常系: ハイフンのみ", q: "-"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			got, err := NewAuthorRepository(db).FindByRomaji(context.Background(), tt.q)
+			if err != nil {
+				t.Fatalf("FindByRomaji() error = %v", err)
+			}
+			if len(got) != 0 {
+				t.Errorf("FindByRomaji() = %v, want no authors", got)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAuthorRepository_FindByRomaji(t *testing.T) {
+	now := time.Now()
+	columns := []string{"id", "creator_name", "creator_name_phonic", "creator_add_time", "creator_update_time", "creator_delete_time", "creator_canonical_id"}
+	tests := []struct {
+		name     string
+		q        string
+		wantArgs string
+	}{
+		{name: "正常系", q: "natsume", wantArgs: "natsume"},
+		{name: "正常系: 区切りと大文字と長音を揃える", q: "Natsume Souseki", wantArgs: "natsumesoseki"},
+		{name: "正常系: 長音記号", q: "Sōseki", wantArgs: "soseki"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			mock.ExpectQuery(`SELECT .* FROM "creator"\s+WHERE REPLACE\("creator_name_romaji", ' ', ''\) LIKE '%' \|\| \$1 \|\| '%' AND "creator_delete_time" IS NULL`).
+				WithArgs(tt.wantArgs).
+				WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(ulid.NewULID(), "夏目漱石", "ナツメ ソウセキ", now, now, nil, nil).
+					AddRow(ulid.NewULID(), "夏目伸六", "ナツメ シンロク", now, now, nil, nil),
+				)
+
+			got, err := NewAuthorRepository(db).FindByRomaji(context.Background(), tt.q)
+			if err != nil {
+				t.Fatalf("FindByRomaji() error = %v", err)
+			}
+			phonics := []string{}
+			for _, a := range got {
+				phonics = append(phonics, a.NamePhonic())
+			}
+			// 五十音順に並べ直す
+			want := []string{"ナツメ　シンロク", "ナツメ　ソウセキ"}
+			if diff := cmp.Diff(phonics, want); diff != "" {
+				t.Errorf("FindByRomaji() = %v, want = %v.\n error is %s", phonics, want, diff)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAuthorRepository_FindBySlug(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`SELECT .* FROM "creator" WHERE "creator_name_romaji" = \$1 AND "creator_delete_time" IS NULL ORDER BY "id"`).
+		WithArgs("oda eiichiro").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	got, err := NewAuthorRepository(db).FindBySlug(context.Background(), "Oda-Eiichiro")
+	if err != nil || len(got) != 0 {
+		t.Errorf("FindBySlug() = %v, %v, want = [], nil", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAuthorRepository_Purge(t *testing.T) {
+	before := time.Now()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectExec(`DELETE FROM "creator"\s+WHERE "creator_delete_time" < \$1\s+AND NOT EXISTS \(SELECT 1 FROM "author_list"`).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	got, err := NewAuthorRepository(db).Purge(context.Background(), before)
+	if err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+	if got != 2 {
+		t.Errorf("Purge() = %d, want = 2", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func mustAuthor(t *testing.T, id, name, namePhonic string, createAt, lastUpdateAt time.Time) *author.Author {
+	t.Helper()
+	a, err := author.Reconstruct(id, name, namePhonic, nil, createAt, lastUpdateAt, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func mustDeletedAuthor(t *testing.T, id, name, namePhonic string, now time.Time) *author.Author {
+	t.Helper()
+	a, err := author.Reconstruct(id, name, namePhonic, nil, now, now, &now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestAuthorRepository_SaveMerge(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	duplicate, err := author.NewAuthor(clk, "尾田栄一郎", "オダ エイイチロウ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	canonical, err := author.NewAuthor(clk, "尾田 栄一郎", "オダ エイイチロウ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	record, err := author.Merge(clk, duplicate, canonical)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "creator" SET "creator_canonical_id" = \$2`).
+		WithArgs(duplicate.ID(), canonical.ID(), now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT "id" FROM "creator" WHERE "creator_canonical_id" = \$1`).
+		WithArgs(duplicate.ID()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(`INSERT INTO "creator"`).
+		WithArgs(duplicate.ID(), "尾田栄一郎", "オダ　エイイチロウ", now, now, now, canonical.ID(), "oda eiichiro").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "author_list" AS "duplicate"`).
+		WithArgs(duplicate.ID(), canonical.ID()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "author_list" SET "creator_id" = \$2`).
+		WithArgs(duplicate.ID(), canonical.ID(), now).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`INSERT INTO "creator_merge"`).
+		WithArgs(record.ID(), duplicate.ID(), canonical.ID(), now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := NewAuthorRepository(db).SaveMerge(context.Background(), duplicate, record); err != nil {
+		t.Errorf("SaveMerge() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,515 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/authorrole"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/book"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/bookjan"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/isbn"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/pkg/gojuon"
+)
+
+type bookRepository struct {
+	db *sql.DB
+}
+
+func NewBookRepository(db *sql.DB) book.BookRepository {
+	return &bookRepository{db: db}
+}
+
+const bookColumns = `"id", "book_isbn", "book_event_name", "book_circle_name", "book_item_code", "label_id", "book_title", "book_title_phonic", "publish_id", "book_release_day", "book_price", "book_c_code", "size_id", "book_explain", "book_add_time", "book_update_time", "book_delete_time"`
+
+func (r *bookRepository) Save(ctx context.Context, b *book.Book) error {
+	return runInTx(ctx, r.db, func(ctx context.Context) error {
+		db := executor(ctx, r.db)
+		if err := r.checkISBN(ctx, b); err != nil {
+			return err
+		}
+		if err := r.checkAltID(ctx, b); err != nil {
+			return err
+		}
+
+		event, circle, item := altIDColumns(b)
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO "book" (`+bookColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+			ON CONFLICT ("id") DO UPDATE SET
+				"book_isbn" = EXCLUDED."book_isbn",
+				"book_event_name" = EXCLUDED."book_event_name",
+				"book_circle_name" = EXCLUDED."book_circle_name",
+				"book_item_code" = EXCLUDED."book_item_code",
+				"label_id" = EXCLUDED."label_id",
+				"book_title" = EXCLUDED."book_title",
+				"book_title_phonic" = EXCLUDED."book_title_phonic",
+				"publish_id" = EXCLUDED."publish_id",
+				"book_release_day" = EXCLUDED."book_release_day",
+				"book_price" = EXCLUDED."book_price",
+				"book_c_code" = EXCLUDED."book_c_code",
+				"size_id" = EXCLUDED."size_id",
+				"book_explain" = EXCLUDED."book_explain",
+				"book_update_time" = EXCLUDED."book_update_time",
+				"book_delete_time" = EXCLUDED."book_delete_time"`,
+			b.ID(), isbnCode(b), event, circle, item, b.LabelID(), b.Title(), b.TitlePhonic(), b.PublishID(),
+			b.ReleaseDay(), b.Price(), cCodeDigits(b), b.SizeID(), b.Explain(), b.CreateAt(), b.LastUpdateAt(), b.DeletedAt(),
+		)
+		if err != nil {
+			return uniqueViolation(err)
+		}
+
+		// 著者リストは洗い替える
+		if _, err := db.ExecContext(ctx, `DELETE FROM "author_list" WHERE "book_id" = $1`, b.ID()); err != nil {
+			return err
+		}
+		for i, author := range b.Authors() {
+			_, err := db.ExecContext(ctx, `
+				INSERT INTO "author_list" ("book_id", "creator_id", "author_list_role", "author_list_order", "author_list_add_time", "author_list_update_time")
+				VALUES ($1, $2, $3, $4, $5, $5)`,
+				b.ID(), author.AuthorID(), author.Role().String(), i, b.LastUpdateAt(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		// タグリストも洗い替える
+		if _, err := db.ExecContext(ctx, `DELETE FROM "tag_list" WHERE "book_id" = $1`, b.ID()); err != nil {
+			return err
+		}
+		for _, tagID := range b.TagIDs() {
+			_, err := db.ExecContext(ctx, `
+				INSERT INTO "tag_list" ("book_id", "tag_id", "tag_list_add_time", "tag_list_update_time")
+				VALUES ($1, $2, $3, $3)`,
+				b.ID(), tagID, b.LastUpdateAt(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// checkISBN は同じISBNの書籍が他に登録されていないか調べる
+// DBの一意制約と同じく論理削除した書籍も対象にする
+func (r *bookRepository) checkISBN(ctx context.Context, b *book.Book) error {
+	code, ok := b.ISBN()
+	if !ok {
+		return nil
+	}
+	var id string
+	err := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT "id" FROM "book" WHERE "book_isbn" = $1 AND "id" <> $2`,
+		code.String(), b.ID(),
+	).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return book.ErrDuplicateISBN
+}
+
+// uniqueViolation は事前のチェックの後に同時に保存された書籍と一意制約で衝突した場合に、
+// チェックと同じドメインのエラーにする
+func uniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "23505" {
+		return err
+	}
+	switch pgErr.ConstraintName {
+	// 0001 の UNIQUE で自動で付いた名前
+	case "book_book_isbn_key":
+		return book.ErrDuplicateISBN
+	case "book_alt_id_key":
+		return book.ErrDuplicateAltID
+	}
+	return err
+}
+
+// checkAltID は同じ代替識別子の書籍が他に登録されていないか調べる
+func (r *bookRepository) checkAltID(ctx context.Context, b *book.Book) error {
+	a, ok := b.AltID()
+	if !ok {
+		return nil
+	}
+	var id string
+	err := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT "id" FROM "book" WHERE "book_event_name" = $1 AND "book_circle_name" = $2 AND "book_item_code" = $3 AND "id" <> $4`,
+		a.Event(), a.Circle(), a.Item(), b.ID(),
+	).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return book.ErrDuplicateAltID
+}
+
+func (r *bookRepository) FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*book.Book, error) {
+	db := executor(ctx, r.db)
+	row, err := scanBookRow(db.QueryRowContext(ctx,
+		`SELECT `+bookColumns+` FROM "book" WHERE "id" = $1 AND `+notDeleted(`"book_delete_time"`, opts),
+		id,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errDomain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	authors, err := r.findAuthors(ctx, `WHERE "book_id" = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := r.findTags(ctx, `WHERE "book_id" = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	return row.toBook(authors[id], tags[id])
+}
+
+func (r *bookRepository) FindByISBN(ctx context.Context, code isbn.ISBN, opts ...repository.FindOption) (*book.Book, error) {
+	books, err := r.findBooks(ctx, `WHERE "book_isbn" = $1 AND `+notDeleted(`"book_delete_time"`, opts), code.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(books) == 0 {
+		return nil, errDomain.ErrNotFound
+	}
+	return books[0], nil
+}
+
+func (r *bookRepository) FindByAltID(ctx context.Context, altID book.AltID, opts ...repository.FindOption) (*book.Book, error) {
+	books, err := r.findBooks(ctx,
+		`WHERE "book_event_name" = $1 AND "book_circle_name" = $2 AND "book_item_code" = $3 AND `+notDeleted(`"book_delete_time"`, opts),
+		altID.Event(), altID.Circle(), altID.Item(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(books) == 0 {
+		return nil, errDomain.ErrNotFound
+	}
+	return books[0], nil
+}
+
+func (r *bookRepository) FindAll(ctx context.Context, opts ...repository.FindOption) ([]*book.Book, error) {
+	return r.findBooks(ctx, `WHERE `+notDeleted(`"book_delete_time"`, opts))
+}
+
+// FindAllOrderByReading はDBの照合順序によらず五十音順になるようにアプリケーション側で並べる
+func (r *bookRepository) FindAllOrderByReading(ctx context.Context, opts ...repository.FindOption) ([]*book.Book, error) {
+	books, err := r.FindAll(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	gojuon.Sort(books, (*book.Book).SortReading)
+	return books, nil
+}
+
+func (r *bookRepository) FindBySizeID(ctx context.Context, sizeID string, opts ...repository.FindOption) ([]*book.Book, error) {
+	return r.findBooks(ctx, `WHERE "size_id" = $1 AND `+notDeleted(`"book_delete_time"`, opts), sizeID)
+}
+
+// FindByTagIDs は指定したタグがすべて付与されている書籍を返す
+func (r *bookRepository) FindByTagIDs(ctx context.Context, tagIDs []string, opts ...repository.FindOption) ([]*book.Book, error) {
+	if len(tagIDs) == 0 {
+		return nil, nil
+	}
+	// 同じタグが重複して指定されると件数が一致しなくなるため、重複を除いてから数える
+	args := make([]any, 0, len(tagIDs)+1)
+	seen := make(map[string]struct{}, len(tagIDs))
+	for _, tagID := range tagIDs {
+		if _, ok := seen[tagID]; ok {
+			continue
+		}
+		seen[tagID] = struct{}{}
+		args = append(args, tagID)
+	}
+	count := len(args)
+	args = append(args, count)
+	return r.findBooks(ctx, `WHERE "id" IN (
+		SELECT "book_id" FROM "tag_list"
+		WHERE "tag_id" IN (`+placeholders(1, count)+`)
+		GROUP BY "book_id"
+		HAVING COUNT(DISTINCT "tag_id") = $`+strconv.Itoa(count+1)+`
+	) AND `+notDeleted(`"book_delete_time"`, opts), args...)
+}
+
+// FindByAuthorID は役割によらず authorID がクレジットされている書籍を返す
+func (r *bookRepository) FindByAuthorID(ctx context.Context, authorID string, opts ...repository.FindOption) ([]*book.Book, error) {
+	return r.findBooks(ctx, `WHERE "id" IN (
+		SELECT "book_id" FROM "author_list" WHERE "creator_id" = $1
+	) AND `+notDeleted(`"book_delete_time"`, opts), authorID)
+}
+
+// FindByAuthorRole は authorID が role でクレジットされている書籍を返す
+func (r *bookRepository) FindByAuthorRole(ctx context.Context, authorID string, role authorrole.Role, opts ...repository.FindOption) ([]*book.Book, error) {
+	return r.findBooks(ctx, `WHERE "id" IN (
+		SELECT "book_id" FROM "author_list" WHERE "creator_id" = $1 AND "author_list_role" = $2
+	) AND `+notDeleted(`"book_delete_time"`, opts), authorID, role.String())
+}
+
+func (r *bookRepository) FindByISBNPrefix(ctx context.Context, prefix string, opts ...repository.FindOption) ([]*book.Book, error) {
+	return r.findBooks(ctx, `WHERE "book_isbn" LIKE $1 || '%' AND `+notDeleted(`"book_delete_time"`, opts), prefix)
+}
+
+// findBooks は条件に一致する書籍を著者リストと合わせて返す
+func (r *bookRepository) findBooks(ctx context.Context, where string, args ...any) ([]*book.Book, error) {
+	db := executor(ctx, r.db)
+	rows, err := db.QueryContext(ctx, `SELECT `+bookColumns+` FROM "book" `+where+` ORDER BY "id"`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bookRows []*bookRow
+	for rows.Next() {
+		row, err := scanBookRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		bookRows = append(bookRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	authors, err := r.findAuthors(ctx, `WHERE "book_id" IN (SELECT "id" FROM "book" `+where+`)`, args...)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := r.findTags(ctx, `WHERE "book_id" IN (SELECT "id" FROM "book" `+where+`)`, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	books := make([]*book.Book, 0, len(bookRows))
+	for _, row := range bookRows {
+		b, err := row.toBook(authors[row.id], tags[row.id])
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, b)
+	}
+	return books, nil
+}
+
+// Delete は Purge と同じく、シリーズに含まれている書籍は削除しない
+// 唯一の巻を消すとシリーズが読み込めなくなるため、先にシリーズから外してもらう
+func (r *bookRepository) Delete(ctx context.Context, id string) error {
+	return runInTx(ctx, r.db, func(ctx context.Context) error {
+		db := executor(ctx, r.db)
+		var inSeries bool
+		if err := db.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM "series_list" WHERE "book_id" = $1)`, id,
+		).Scan(&inSeries); err != nil {
+			return err
+		}
+		if inSeries {
+			return book.ErrInSeries
+		}
+		if _, err := db.ExecContext(ctx, `DELETE FROM "author_list" WHERE "book_id" = $1`, id); err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, `DELETE FROM "tag_list" WHERE "book_id" = $1`, id); err != nil {
+			return err
+		}
+		_, err := db.ExecContext(ctx, `DELETE FROM "book" WHERE "id" = $1`, id)
+		return err
+	})
+}
+
+// Purge はシリーズに含まれていない書籍のみ、著者リストとタグリストを外してから削除する
+func (r *bookRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	const purgeTarget = `
+		SELECT "id" FROM "book"
+		WHERE "book_delete_time" < $1
+			AND NOT EXISTS (SELECT 1 FROM "series_list" WHERE "series_list"."book_id" = "book"."id")`
+
+	var purged int64
+	err := runInTx(ctx, r.db, func(ctx context.Context) error {
+		db := executor(ctx, r.db)
+		if _, err := db.ExecContext(ctx, `DELETE FROM "author_list" WHERE "book_id" IN (`+purgeTarget+`)`, before); err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx, `DELETE FROM "tag_list" WHERE "book_id" IN (`+purgeTarget+`)`, before); err != nil {
+			return err
+		}
+		result, err := db.ExecContext(ctx, `DELETE FROM "book" WHERE "id" IN (`+purgeTarget+`)`, before)
+		if err != nil {
+			return err
+		}
+		purged, err = result.RowsAffected()
+		return err
+	})
+	return purged, err
+}
+
+func (r *bookRepository) CountUnpurged(ctx context.Context, before time.Time) (int64, error) {
+	var count int64
+	err := executor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM "book"
+		WHERE "book_delete_time" < $1
+			AND EXISTS (SELECT 1 FROM "series_list" WHERE "series_list"."book_id" = "book"."id")`,
+		before,
+	).Scan(&count)
+	return count, err
+}
+
+// findAuthors は書籍IDごとの著者リストを奥付の順で返す
+func (r *bookRepository) findAuthors(ctx context.Context, where string, args ...any) (map[string][]book.BookAuthor, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT "book_id", "creator_id", "author_list_role" FROM "author_list" `+where+` ORDER BY "book_id", "author_list_order", "id"`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	authors := map[string][]book.BookAuthor{}
+	for rows.Next() {
+		var bookID, authorID, role string
+		if err := rows.Scan(&bookID, &authorID, &role); err != nil {
+			return nil, err
+		}
+		authors[bookID] = append(authors[bookID], book.NewBookAuthor(authorID, authorrole.Role(role)))
+	}
+	return authors, rows.Err()
+}
+
+// findTags は書籍IDごとのタグリストを登録順で返す
+func (r *bookRepository) findTags(ctx context.Context, where string, args ...any) (map[string][]book.BookTag, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT "book_id", "tag_id" FROM "tag_list" `+where+` ORDER BY "book_id", "id"`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := map[string][]book.BookTag{}
+	for rows.Next() {
+		var bookID, tagID string
+		if err := rows.Scan(&bookID, &tagID); err != nil {
+			return nil, err
+		}
+		tags[bookID] = append(tags[bookID], book.NewBookTag(tagID))
+	}
+	return tags, rows.Err()
+}
+
+type bookRow struct {
+	id           string
+	isbn         sql.NullString
+	event        sql.NullString
+	circle       sql.NullString
+	item         sql.NullString
+	labelID      string
+	title        string
+	titlePhonic  sql.NullString
+	publishID    string
+	releaseDay   sql.NullTime
+	price        sql.NullInt64
+	cCode        sql.NullString
+	sizeID       string
+	explain      sql.NullString
+	createAt     time.Time
+	lastUpdateAt sql.NullTime
+	deletedAt    sql.NullTime
+}
+
+func scanBookRow(s scanner) (*bookRow, error) {
+	var row bookRow
+	err := s.Scan(
+		&row.id, &row.isbn, &row.event, &row.circle, &row.item, &row.labelID, &row.title, &row.titlePhonic, &row.publishID,
+		&row.releaseDay, &row.price, &row.cCode, &row.sizeID, &row.explain, &row.createAt, &row.lastUpdateAt, &row.deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (row *bookRow) toBook(authors []book.BookAuthor, tags []book.BookTag) (*book.Book, error) {
+	var isbn *string
+	if row.isbn.Valid {
+		isbn = &row.isbn.String
+	}
+	var altID *book.AltID
+	if row.event.Valid && row.circle.Valid && row.item.Valid {
+		a, err := book.NewAltID(row.event.String, row.circle.String, row.item.String)
+		if err != nil {
+			return nil, err
+		}
+		altID = &a
+	}
+	var cCode *bookjan.CCode
+	if row.cCode.Valid {
+		c, err := bookjan.NewCCode(row.cCode.String)
+		if err != nil {
+			return nil, err
+		}
+		cCode = &c
+	}
+	return book.Reconstruct(
+		row.id,
+		isbn,
+		altID,
+		row.labelID,
+		row.publishID,
+		row.sizeID,
+		row.title,
+		row.titlePhonic.String,
+		authors,
+		tags,
+		row.releaseDay.Time,
+		int(row.price.Int64),
+		cCode,
+		row.explain.String,
+		row.createAt,
+		updateTime(row.createAt, row.lastUpdateAt),
+		nullTimeToPtr(row.deletedAt),
+	)
+}
+
+// cCodeDigits は分類コードをCを除いた4桁で保存する
+func cCodeDigits(b *book.Book) *string {
+	c, ok := b.CCode()
+	if !ok {
+		return nil
+	}
+	digits := c.Digits()
+	return &digits
+}
+
+func isbnCode(b *book.Book) *string {
+	code, ok := b.ISBN()
+	if !ok {
+		return nil
+	}
+	s := code.String()
+	return &s
+}
+
+func altIDColumns(b *book.Book) (*string, *string, *string) {
+	a, ok := b.AltID()
+	if !ok {
+		return nil, nil, nil
+	}
+	event, circle, item := a.Event(), a.Circle(), a.Item()
+	return &event, &circle, &item
+}
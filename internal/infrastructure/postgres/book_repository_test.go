@@ -0,0 +1,616 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/authorrole"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/book"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/bookjan"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/isbn"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+func TestBookRepository_FindByID(t *testing.T) {
+	id := ulid.NewULID()
+	labelID := ulid.NewULID()
+	publishID := ulid.NewULID()
+	sizeID := ulid.NewULID()
+	authorID1 := ulid.NewULID()
+	authorID2 := ulid.NewULID()
+	tagID := ulid.NewULID()
+	code := "9784758079211"
+	cCode, err := bookjan.NewCCode("C0079")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`SELECT .* FROM "book" WHERE "id" = \$1`).WithArgs(id).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "book_isbn", "book_event_name", "book_circle_name", "book_item_code", "label_id", "book_title", "book_title_phonic", "publish_id", "book_release_day", "book_price", "book_c_code", "size_id", "book_explain", "book_add_time", "book_update_time", "book_delete_time"}).
+			AddRow(id, code, nil, nil, nil, labelID, "書籍タイトル", "", publishID, now, 800, "0079", sizeID, "書籍の説明", now, now, nil),
+	)
+	mock.ExpectQuery(`SELECT "book_id", "creator_id", "author_list_role" FROM "author_list" WHERE "book_id" = \$1`).WithArgs(id).WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "creator_id", "author_list_role"}).
+			AddRow(id, authorID1, "原作").
+			AddRow(id, authorID2, "画"),
+	)
+	mock.ExpectQuery(`SELECT "book_id", "tag_id" FROM "tag_list" WHERE "book_id" = \$1`).WithArgs(id).WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "tag_id"}).AddRow(id, tagID),
+	)
+
+	got, err := NewBookRepository(db).FindByID(context.Background(), id)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	want, err := book.Reconstruct(
+		id, &code, nil, labelID, publishID, sizeID, "書籍タイトル", "",
+		[]book.BookAuthor{book.NewBookAuthor(authorID1, authorrole.Original), book.NewBookAuthor(authorID2, authorrole.Artist)},
+		[]book.BookTag{book.NewBookTag(tagID)},
+		now, 800, &cCode, "書籍の説明", now, now, nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(book.Book{}, book.BookAuthor{}, book.BookTag{}, bookjan.CCode{}, isbn.ISBN{})); diff != "" {
+		t.Errorf("FindByID() = %v, want = %v.\n error is %s", got, want, diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_Save(t *testing.T) {
+	labelID := ulid.NewULID()
+	publishID := ulid.NewULID()
+	sizeID := ulid.NewULID()
+	authorID1 := ulid.NewULID()
+	authorID2 := ulid.NewULID()
+	tagID := ulid.NewULID()
+	now := time.Now()
+	b, err := book.NewBook(
+		clock.NewFixed(now),
+		nil, nil, labelID, publishID, sizeID, "書籍タイトル", "",
+		[]book.BookAuthor{book.NewBookAuthor(authorID1, authorrole.Original), book.NewBookAuthor(authorID2, authorrole.Artist)},
+		[]book.BookTag{book.NewBookTag(tagID)},
+		now, 800, nil, "書籍の説明",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "book"`).
+		WithArgs(b.ID(), nil, nil, nil, nil, labelID, "書籍タイトル", "", publishID, now, 800, nil, sizeID, "書籍の説明", now, now, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "author_list"`).WithArgs(b.ID()).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO "author_list"`).WithArgs(b.ID(), authorID1, "原作", 0, now).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "author_list"`).WithArgs(b.ID(), authorID2, "画", 1, now).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "tag_list"`).WithArgs(b.ID()).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO "tag_list"`).WithArgs(b.ID(), tagID, now).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := NewBookRepository(db).Save(context.Background(), b); err != nil {
+		t.Errorf("Save() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_Save_AltID(t *testing.T) {
+	labelID := ulid.NewULID()
+	publishID := ulid.NewULID()
+	sizeID := ulid.NewULID()
+	authorID := ulid.NewULID()
+	now := time.Now()
+	altID, err := book.NewAltID("コミックマーケット105", "サークル", "新刊A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := book.NewBook(
+		clock.NewFixed(now),
+		nil, &altID, labelID, publishID, sizeID, "書籍タイトル", "",
+		[]book.BookAuthor{book.NewBookAuthor(authorID, authorrole.Writer)},
+		nil, now, 800, nil, "書籍の説明",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "book" WHERE "book_event_name" = \$1 AND "book_circle_name" = \$2 AND "book_item_code" = \$3 AND "id" <> \$4`).
+		WithArgs("コミックマーケット105", "サークル", "新刊A", b.ID()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(`INSERT INTO "book"`).
+		WithArgs(b.ID(), nil, "コミックマーケット105", "サークル", "新刊A", labelID, "書籍タイトル", "", publishID, now, 800, nil, sizeID, "書籍の説明", now, now, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "author_list"`).WithArgs(b.ID()).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO "author_list"`).WithArgs(b.ID(), authorID, "作", 0, now).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "tag_list"`).WithArgs(b.ID()).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	if err := NewBookRepository(db).Save(context.Background(), b); err != nil {
+		t.Errorf("Save() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_Save_DuplicateISBN(t *testing.T) {
+	code := "9784758079211"
+	now := time.Now()
+	b, err := book.NewBook(
+		clock.NewFixed(now),
+		&code, nil, ulid.NewULID(), ulid.NewULID(), ulid.NewULID(), "書籍タイトル", "",
+		[]book.BookAuthor{book.NewBookAuthor(ulid.NewULID(), authorrole.Writer)},
+		nil, now, 800, nil, "書籍の説明",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "book" WHERE "book_isbn" = \$1 AND "id" <> \$2`).
+		WithArgs(code, b.ID()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ulid.NewULID()))
+	mock.ExpectRollback()
+
+	if err := NewBookRepository(db).Save(context.Background(), b); !errors.Is(err, book.ErrDuplicateISBN) {
+		t.Errorf("Save() error = %v, want %v", err, book.ErrDuplicateISBN)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_Save_UniqueViolation(t *testing.T) {
+	code := "9784758079211"
+	now := time.Now()
+	b, err := book.NewBook(
+		clock.NewFixed(now),
+		&code, nil, ulid.NewULID(), ulid.NewULID(), ulid.NewULID(), "書籍タイトル", "",
+		[]book.BookAuthor{book.NewBookAuthor(ulid.NewULID(), authorrole.Writer)},
+		nil, now, 800, nil, "書籍の説明",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	// 事前のチェックの後に同じISBNの書籍が保存された
+	mock.ExpectQuery(`SELECT "id" FROM "book" WHERE "book_isbn" = \$1 AND "id" <> \$2`).
+		WithArgs(code, b.ID()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(`INSERT INTO "book"`).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "book_book_isbn_key"})
+	mock.ExpectRollback()
+
+	if err := NewBookRepository(db).Save(context.Background(), b); !errors.Is(err, book.ErrDuplicateISBN) {
+		t.Errorf("Save() error = %v, want %v", err, book.ErrDuplicateISBN)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_Save_DuplicateAltID(t *testing.T) {
+	now := time.Now()
+	altID, err := book.NewAltID("コミックマーケット105", "サークル", "新刊A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := book.NewBook(
+		clock.NewFixed(now),
+		nil, &altID, ulid.NewULID(), ulid.NewULID(), ulid.NewULID(), "書籍タイトル", "",
+		[]book.BookAuthor{book.NewBookAuthor(ulid.NewULID(), authorrole.Writer)},
+		nil, now, 800, nil, "書籍の説明",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "book" WHERE "book_event_name" = \$1 AND "book_circle_name" = \$2 AND "book_item_code" = \$3 AND "id" <> \$4`).
+		WithArgs("コミックマーケット105", "サークル", "新刊A", b.ID()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ulid.NewULID()))
+	mock.ExpectRollback()
+
+	if err := NewBookRepository(db).Save(context.Background(), b); !errors.Is(err, book.ErrDuplicateAltID) {
+		t.Errorf("Save() error = %v, want %v", err, book.ErrDuplicateAltID)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_FindByAltID(t *testing.T) {
+	id := ulid.NewULID()
+	authorID := ulid.NewULID()
+	now := time.Now()
+	altID, err := book.NewAltID("コミックマーケット105", "サークル", "新刊A")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`SELECT .* FROM "book" WHERE "book_event_name" = \$1 AND "book_circle_name" = \$2 AND "book_item_code" = \$3 AND "book_delete_time" IS NULL ORDER BY "id"`).
+		WithArgs("コミックマーケット105", "サークル", "新刊A").
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "book_isbn", "book_event_name", "book_circle_name", "book_item_code", "label_id", "book_title", "book_title_phonic", "publish_id", "book_release_day", "book_price", "book_c_code", "size_id", "book_explain", "book_add_time", "book_update_time", "book_delete_time"}).
+				AddRow(id, nil, "コミックマーケット105", "サークル", "新刊A", ulid.NewULID(), "書籍タイトル", "", ulid.NewULID(), now, 800, nil, ulid.NewULID(), nil, now, now, nil),
+		)
+	mock.ExpectQuery(`SELECT "book_id", "creator_id", "author_list_role" FROM "author_list"`).WithArgs("コミックマーケット105", "サークル", "新刊A").WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "creator_id", "author_list_role"}).AddRow(id, authorID, "作"),
+	)
+	mock.ExpectQuery(`SELECT "book_id", "tag_id" FROM "tag_list"`).WithArgs("コミックマーケット105", "サークル", "新刊A").WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "tag_id"}),
+	)
+
+	got, err := NewBookRepository(db).FindByAltID(context.Background(), altID)
+	if err != nil {
+		t.Fatalf("FindByAltID() error = %v", err)
+	}
+	if a, ok := got.AltID(); !ok || a != altID {
+		t.Errorf("FindByAltID().AltID() = %v, want = %v", a, altID)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_FindAllOrderByReading(t *testing.T) {
+	labelID := ulid.NewULID()
+	publishID := ulid.NewULID()
+	sizeID := ulid.NewULID()
+	ids := []string{ulid.NewULID(), ulid.NewULID(), ulid.NewULID()}
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	rows := sqlmock.NewRows([]string{"id", "book_isbn", "book_event_name", "book_circle_name", "book_item_code", "label_id", "book_title", "book_title_phonic", "publish_id", "book_release_day", "book_price", "book_c_code", "size_id", "book_explain", "book_add_time", "book_update_time", "book_delete_time"})
+	for _, row := range []struct{ id, title, phonic string }{
+		{ids[0], "吾輩は猫である", "ワガハイハネコデアル"},
+		{ids[1], "Another", ""},
+		{ids[2], "こころ", "ココロ"},
+	} {
+		rows.AddRow(row.id, nil, nil, nil, nil, labelID, row.title, row.phonic, publishID, now, 800, nil, sizeID, nil, now, now, nil)
+	}
+	mock.ExpectQuery(`SELECT .* FROM "book" WHERE "book_delete_time" IS NULL ORDER BY "id"`).WillReturnRows(rows)
+	mock.ExpectQuery(`SELECT "book_id", "creator_id", "author_list_role" FROM "author_list"`).WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "creator_id", "author_list_role"}).AddRow(ids[0], ulid.NewULID(), "作").AddRow(ids[1], ulid.NewULID(), "作").AddRow(ids[2], ulid.NewULID(), "作"),
+	)
+	mock.ExpectQuery(`SELECT "book_id", "tag_id" FROM "tag_list"`).WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "tag_id"}),
+	)
+
+	got, err := NewBookRepository(db).FindAllOrderByReading(context.Background())
+	if err != nil {
+		t.Fatalf("FindAllOrderByReading() error = %v", err)
+	}
+	var titles []string
+	for _, b := range got {
+		titles = append(titles, b.Title())
+	}
+	want := []string{"こころ", "吾輩は猫である", "Another"}
+	if diff := cmp.Diff(titles, want); diff != "" {
+		t.Errorf("FindAllOrderByReading() = %v, want = %v.\n error is %s", titles, want, diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_FindBySizeID(t *testing.T) {
+	id := ulid.NewULID()
+	labelID := ulid.NewULID()
+	publishID := ulid.NewULID()
+	sizeID := ulid.NewULID()
+	authorID := ulid.NewULID()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`SELECT .* FROM "book" WHERE "size_id" = \$1 AND "book_delete_time" IS NULL ORDER BY "id"`).WithArgs(sizeID).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "book_isbn", "book_event_name", "book_circle_name", "book_item_code", "label_id", "book_title", "book_title_phonic", "publish_id", "book_release_day", "book_price", "book_c_code", "size_id", "book_explain", "book_add_time", "book_update_time", "book_delete_time"}).
+			AddRow(id, nil, nil, nil, nil, labelID, "書籍タイトル", "", publishID, now, nil, nil, sizeID, nil, now, nil, nil),
+	)
+	mock.ExpectQuery(`SELECT "book_id", "creator_id", "author_list_role" FROM "author_list" WHERE "book_id" IN \(SELECT "id" FROM "book" WHERE "size_id" = \$1 AND "book_delete_time" IS NULL\)`).WithArgs(sizeID).WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "creator_id", "author_list_role"}).AddRow(id, authorID, "作"),
+	)
+	mock.ExpectQuery(`SELECT "book_id", "tag_id" FROM "tag_list" WHERE "book_id" IN \(SELECT "id" FROM "book" WHERE "size_id" = \$1 AND "book_delete_time" IS NULL\)`).WithArgs(sizeID).WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "tag_id"}),
+	)
+
+	got, err := NewBookRepository(db).FindBySizeID(context.Background(), sizeID)
+	if err != nil {
+		t.Fatalf("FindBySizeID() error = %v", err)
+	}
+	if len(got) != 1 || got[0].ID() != id || got[0].SizeID() != sizeID {
+		t.Errorf("FindBySizeID() = %v, want book %s", got, id)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_FindByTagIDs(t *testing.T) {
+	id := ulid.NewULID()
+	labelID := ulid.NewULID()
+	publishID := ulid.NewULID()
+	sizeID := ulid.NewULID()
+	authorID := ulid.NewULID()
+	tagID1 := ulid.NewULID()
+	tagID2 := ulid.NewULID()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`SELECT .* FROM "book" WHERE "id" IN \(\s*SELECT "book_id" FROM "tag_list"\s*WHERE "tag_id" IN \(\$1, \$2\)\s*GROUP BY "book_id"\s*HAVING COUNT\(DISTINCT "tag_id"\) = \$3`).
+		WithArgs(tagID1, tagID2, 2).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "book_isbn", "book_event_name", "book_circle_name", "book_item_code", "label_id", "book_title", "book_title_phonic", "publish_id", "book_release_day", "book_price", "book_c_code", "size_id", "book_explain", "book_add_time", "book_update_time", "book_delete_time"}).
+				AddRow(id, nil, nil, nil, nil, labelID, "書籍タイトル", "", publishID, now, 800, nil, sizeID, nil, now, now, nil),
+		)
+	mock.ExpectQuery(`SELECT "book_id", "creator_id", "author_list_role" FROM "author_list"`).WithArgs(tagID1, tagID2, 2).WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "creator_id", "author_list_role"}).AddRow(id, authorID, "作"),
+	)
+	mock.ExpectQuery(`SELECT "book_id", "tag_id" FROM "tag_list"`).WithArgs(tagID1, tagID2, 2).WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "tag_id"}).AddRow(id, tagID1).AddRow(id, tagID2),
+	)
+
+	got, err := NewBookRepository(db).FindByTagIDs(context.Background(), []string{tagID1, tagID2})
+	if err != nil {
+		t.Fatalf("FindByTagIDs() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("FindByTagIDs() = %v, want 1 book", got)
+	}
+	if diff := cmp.Diff(got[0].TagIDs(), []string{tagID1, tagID2}); diff != "" {
+		t.Errorf("TagIDs() = %v.\n error is %s", got[0].TagIDs(), diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_FindByTagIDs_Duplicate(t *testing.T) {
+	id := ulid.NewULID()
+	labelID := ulid.NewULID()
+	publishID := ulid.NewULID()
+	sizeID := ulid.NewULID()
+	authorID := ulid.NewULID()
+	tagID := ulid.NewULID()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`SELECT .* FROM "book" WHERE "id" IN \(\s*SELECT "book_id" FROM "tag_list"\s*WHERE "tag_id" IN \(\$1\)\s*GROUP BY "book_id"\s*HAVING COUNT\(DISTINCT "tag_id"\) = \$2`).
+		WithArgs(tagID, 1).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "book_isbn", "book_event_name", "book_circle_name", "book_item_code", "label_id", "book_title", "book_title_phonic", "publish_id", "book_release_day", "book_price", "book_c_code", "size_id", "book_explain", "book_add_time", "book_update_time", "book_delete_time"}).
+				AddRow(id, nil, nil, nil, nil, labelID, "書籍タイトル", "", publishID, now, 800, nil, sizeID, nil, now, now, nil),
+		)
+	mock.ExpectQuery(`SELECT "book_id", "creator_id", "author_list_role" FROM "author_list"`).WithArgs(tagID, 1).WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "creator_id", "author_list_role"}).AddRow(id, authorID, "作"),
+	)
+	mock.ExpectQuery(`SELECT "book_id", "tag_id" FROM "tag_list"`).WithArgs(tagID, 1).WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "tag_id"}).AddRow(id, tagID),
+	)
+
+	got, err := NewBookRepository(db).FindByTagIDs(context.Background(), []string{tagID, tagID})
+	if err != nil {
+		t.Fatalf("FindByTagIDs() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("FindByTagIDs() = %v, want 1 book", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_FindByISBNPrefix(t *testing.T) {
+	id := ulid.NewULID()
+	labelID := ulid.NewULID()
+	publishID := ulid.NewULID()
+	sizeID := ulid.NewULID()
+	authorID := ulid.NewULID()
+	code := "9784758079211"
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`SELECT .* FROM "book" WHERE "book_isbn" LIKE \$1 \|\| '%' AND "book_delete_time" IS NULL ORDER BY "id"`).WithArgs("97847580").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "book_isbn", "book_event_name", "book_circle_name", "book_item_code", "label_id", "book_title", "book_title_phonic", "publish_id", "book_release_day", "book_price", "book_c_code", "size_id", "book_explain", "book_add_time", "book_update_time", "book_delete_time"}).
+			AddRow(id, code, nil, nil, nil, labelID, "書籍タイトル", "", publishID, now, 800, nil, sizeID, nil, now, now, nil),
+	)
+	mock.ExpectQuery(`SELECT "book_id", "creator_id", "author_list_role" FROM "author_list"`).WithArgs("97847580").WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "creator_id", "author_list_role"}).AddRow(id, authorID, "作"),
+	)
+	mock.ExpectQuery(`SELECT "book_id", "tag_id" FROM "tag_list"`).WithArgs("97847580").WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "tag_id"}),
+	)
+
+	got, err := NewBookRepository(db).FindByISBNPrefix(context.Background(), "97847580")
+	if err != nil {
+		t.Fatalf("FindByISBNPrefix() error = %v", err)
+	}
+	if len(got) != 1 || got[0].PublishID() != publishID {
+		t.Errorf("FindByISBNPrefix() = %v, want book %s", got, id)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_FindByAuthorRole(t *testing.T) {
+	id := ulid.NewULID()
+	labelID := ulid.NewULID()
+	publishID := ulid.NewULID()
+	sizeID := ulid.NewULID()
+	writerID := ulid.NewULID()
+	illustratorID := ulid.NewULID()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`SELECT .* FROM "book" WHERE "id" IN \(\s*SELECT "book_id" FROM "author_list" WHERE "creator_id" = \$1 AND "author_list_role" = \$2\s*\) AND "book_delete_time" IS NULL ORDER BY "id"`).
+		WithArgs(illustratorID, "イラスト").
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "book_isbn", "book_event_name", "book_circle_name", "book_item_code", "label_id", "book_title", "book_title_phonic", "publish_id", "book_release_day", "book_price", "book_c_code", "size_id", "book_explain", "book_add_time", "book_update_time", "book_delete_time"}).
+				AddRow(id, nil, nil, nil, nil, labelID, "書籍タイトル", "", publishID, now, 800, nil, sizeID, nil, now, now, nil),
+		)
+	mock.ExpectQuery(`SELECT "book_id", "creator_id", "author_list_role" FROM "author_list"`).WithArgs(illustratorID, "イラスト").WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "creator_id", "author_list_role"}).
+			AddRow(id, writerID, "作").
+			AddRow(id, illustratorID, "イラスト"),
+	)
+	mock.ExpectQuery(`SELECT "book_id", "tag_id" FROM "tag_list"`).WithArgs(illustratorID, "イラスト").WillReturnRows(
+		sqlmock.NewRows([]string{"book_id", "tag_id"}),
+	)
+
+	got, err := NewBookRepository(db).FindByAuthorRole(context.Background(), illustratorID, authorrole.Illustrator)
+	if err != nil {
+		t.Fatalf("FindByAuthorRole() error = %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("FindByAuthorRole() = %v, want book %s", got, id)
+	}
+	want := []book.BookAuthor{book.NewBookAuthor(writerID, authorrole.Writer), book.NewBookAuthor(illustratorID, authorrole.Illustrator)}
+	if diff := cmp.Diff(got[0].Authors(), want, cmp.AllowUnexported(book.BookAuthor{})); diff != "" {
+		t.Errorf("Authors() = %v, want = %v.\n error is %s", got[0].Authors(), want, diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_Delete(t *testing.T) {
+	id := ulid.NewULID()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM "series_list" WHERE "book_id" = \$1\)`).WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec(`DELETE FROM "author_list" WHERE "book_id" = \$1`).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "tag_list" WHERE "book_id" = \$1`).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "book" WHERE "id" = \$1`).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := NewBookRepository(db).Delete(context.Background(), id); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_Delete_InSeries(t *testing.T) {
+	// シリーズの唯一の巻でも、シリーズから外さずに削除するとシリーズが読み込めなくなるため拒否する
+	id := ulid.NewULID()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM "series_list" WHERE "book_id" = \$1\)`).WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	if err := NewBookRepository(db).Delete(context.Background(), id); !errors.Is(err, book.ErrInSeries) {
+		t.Errorf("Delete() error = %v, want %v", err, book.ErrInSeries)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBookRepository_CountUnpurged(t *testing.T) {
+	before := time.Now()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM "book"\s+WHERE "book_delete_time" < \$1\s+AND EXISTS \(SELECT 1 FROM "series_list"`).
+		WithArgs(before).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	got, err := NewBookRepository(db).CountUnpurged(context.Background(), before)
+	if err != nil {
+		t.Fatalf("CountUnpurged() error = %v", err)
+	}
+	if got != 3 {
+		t.Errorf("CountUnpurged() = %d, want = 3", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
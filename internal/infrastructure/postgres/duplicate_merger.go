@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/author"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/duplicate"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+)
+
+type duplicateMerger struct {
+	db         *sql.DB
+	candidates *candidateRepository
+	authors    *authorRepository
+	books      *bookRepository
+	labels     *labelRepository
+	publishes  *publishRepository
+}
+
+func NewDuplicateMerger(db *sql.DB) duplicate.Merger {
+	return &duplicateMerger{
+		db:         db,
+		candidates: &candidateRepository{db: db},
+		authors:    &authorRepository{db: db},
+		books:      &bookRepository{db: db},
+		labels:     &labelRepository{db: db},
+		publishes:  &publishRepository{db: db},
+	}
+}
+
+func (m *duplicateMerger) Merge(ctx context.Context, clk clock.Clock, c *duplicate.Candidate) error {
+	if c.Status() != duplicate.StatusPending {
+		return errDomain.NewMessageError(errDomain.CodeConflict, "status", "duplicate.already_resolved")
+	}
+	return runInTx(ctx, m.db, func(ctx context.Context) error {
+		var err error
+		switch c.Kind() {
+		case duplicate.KindAuthor:
+			err = m.mergeAuthor(ctx, clk, c)
+		case duplicate.KindBook:
+			err = m.mergeBook(ctx, clk, c)
+		case duplicate.KindLabel:
+			err = m.mergeLabel(ctx, clk, c)
+		case duplicate.KindPublish:
+			err = m.mergePublish(ctx, clk, c)
+		}
+		if err != nil {
+			return err
+		}
+
+		// 統合元を含む他の候補は意味がなくなるので消す。次回の検出で統合先との組として見直す
+		if _, err := executor(ctx, m.db).ExecContext(ctx, `
+			DELETE FROM "duplicate_candidate"
+			WHERE "candidate_kind" = $1 AND "candidate_status" = $2 AND "id" <> $3
+				AND ("duplicate_id" = $4 OR "canonical_id" = $4)`,
+			string(c.Kind()), string(duplicate.StatusPending), c.ID(), c.DuplicateID(),
+		); err != nil {
+			return err
+		}
+
+		next := *c
+		if err := next.MarkMerged(clk); err != nil {
+			return err
+		}
+		if err := m.candidates.Save(ctx, &next); err != nil {
+			return err
+		}
+		*c = next
+		return nil
+	})
+}
+
+// mergeAuthor は統合元を統合先の別名にし、クレジットを付け替える
+func (m *duplicateMerger) mergeAuthor(ctx context.Context, clk clock.Clock, c *duplicate.Candidate) error {
+	dup, err := m.authors.FindByID(ctx, c.DuplicateID())
+	if err != nil {
+		return err
+	}
+	canonical, err := m.authors.FindByID(ctx, c.CanonicalID())
+	if err != nil {
+		return err
+	}
+	record, err := author.Merge(clk, dup, canonical)
+	if err != nil {
+		return err
+	}
+	return m.authors.SaveMerge(ctx, dup, record)
+}
+
+// mergeBook は統合元の著者とタグを統合先に加え、シリーズの巻を付け替えてから統合元を論理削除する
+func (m *duplicateMerger) mergeBook(ctx context.Context, clk clock.Clock, c *duplicate.Candidate) error {
+	dup, err := m.books.FindByID(ctx, c.DuplicateID())
+	if err != nil {
+		return err
+	}
+	canonical, err := m.books.FindByID(ctx, c.CanonicalID())
+	if err != nil {
+		return err
+	}
+
+	authors := canonical.Authors()
+	for _, a := range dup.Authors() {
+		if !slices.Contains(authors, a) {
+			authors = append(authors, a)
+		}
+	}
+	if err := canonical.ChangeAuthors(clk, authors); err != nil {
+		return err
+	}
+	tagIDs := canonical.TagIDs()
+	for _, tagID := range dup.TagIDs() {
+		if !slices.Contains(tagIDs, tagID) {
+			tagIDs = append(tagIDs, tagID)
+		}
+	}
+	if err := canonical.ChangeTags(clk, tagIDs); err != nil {
+		return err
+	}
+	if err := m.books.Save(ctx, canonical); err != nil {
+		return err
+	}
+
+	// 統合先が既に同じシリーズにある場合は、付け替えると重複するため統合元の巻を消す
+	db := executor(ctx, m.db)
+	if _, err := db.ExecContext(ctx, `
+		DELETE FROM "series_list" AS "duplicate"
+		WHERE "duplicate"."book_id" = $1
+			AND EXISTS (
+				SELECT 1 FROM "series_list" AS "canonical"
+				WHERE "canonical"."title_id" = "duplicate"."title_id" AND "canonical"."book_id" = $2
+			)`,
+		dup.ID(), canonical.ID(),
+	); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, `
+		UPDATE "series_list" SET "book_id" = $2, "series_list_update_time" = $3
+		WHERE "book_id" = $1`,
+		dup.ID(), canonical.ID(), clk.Now(),
+	); err != nil {
+		return err
+	}
+
+	if err := dup.Delete(clk); err != nil {
+		return err
+	}
+	return m.books.Save(ctx, dup)
+}
+
+// mergeLabel は書籍のレーベルを付け替えてから統合元を論理削除する
+func (m *duplicateMerger) mergeLabel(ctx context.Context, clk clock.Clock, c *duplicate.Candidate) error {
+	dup, err := m.labels.FindByID(ctx, c.DuplicateID())
+	if err != nil {
+		return err
+	}
+	if _, err := m.labels.FindByID(ctx, c.CanonicalID()); err != nil {
+		return err
+	}
+	if _, err := executor(ctx, m.db).ExecContext(ctx, `
+		UPDATE "book" SET "label_id" = $2, "book_update_time" = $3
+		WHERE "label_id" = $1`,
+		dup.ID(), c.CanonicalID(), clk.Now(),
+	); err != nil {
+		return err
+	}
+	if err := dup.Delete(clk); err != nil {
+		return err
+	}
+	return m.labels.Save(ctx, dup)
+}
+
+// mergePublish は書籍の出版社を付け替えてから統合元を論理削除する
+func (m *duplicateMerger) mergePublish(ctx context.Context, clk clock.Clock, c *duplicate.Candidate) error {
+	dup, err := m.publishes.FindByID(ctx, c.DuplicateID())
+	if err != nil {
+		return err
+	}
+	if _, err := m.publishes.FindByID(ctx, c.CanonicalID()); err != nil {
+		return err
+	}
+	if _, err := executor(ctx, m.db).ExecContext(ctx, `
+		UPDATE "book" SET "publish_id" = $2, "book_update_time" = $3
+		WHERE "publish_id" = $1`,
+		dup.ID(), c.CanonicalID(), clk.Now(),
+	); err != nil {
+		return err
+	}
+	if err := dup.Delete(clk); err != nil {
+		return err
+	}
+	return m.publishes.Save(ctx, dup)
+}
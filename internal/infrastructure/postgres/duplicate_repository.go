@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/duplicate"
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+)
+
+type candidateRepository struct {
+	db *sql.DB
+}
+
+func NewCandidateRepository(db *sql.DB) duplicate.CandidateRepository {
+	return &candidateRepository{db: db}
+}
+
+const candidateColumns = `"id", "candidate_kind", "duplicate_id", "canonical_id", "candidate_reasons", "candidate_status", "candidate_detect_time", "candidate_resolve_time"`
+
+func (r *candidateRepository) Save(ctx context.Context, c *duplicate.Candidate) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO "duplicate_candidate" (`+candidateColumns+`, "candidate_score")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT ("id") DO UPDATE SET
+			"candidate_status" = EXCLUDED."candidate_status",
+			"candidate_resolve_time" = EXCLUDED."candidate_resolve_time"`,
+		candidateArgs(c)...,
+	)
+	return err
+}
+
+func (r *candidateRepository) FindByID(ctx context.Context, id string) (*duplicate.Candidate, error) {
+	row := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+candidateColumns+` FROM "duplicate_candidate" WHERE "id" = $1`,
+		id,
+	)
+	c, err := scanCandidate(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errDomain.ErrNotFound
+	}
+	return c, err
+}
+
+func (r *candidateRepository) FindPending(ctx context.Context) ([]*duplicate.Candidate, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT `+candidateColumns+` FROM "duplicate_candidate"
+		WHERE "candidate_status" = $1
+		ORDER BY "candidate_score" DESC, "candidate_kind", "id"`,
+		string(duplicate.StatusPending),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []*duplicate.Candidate
+	for rows.Next() {
+		c, err := scanCandidate(rows)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, rows.Err()
+}
+
+// ReplacePending は未対応の候補を消してから入れ直す
+// 対応済みの組は一意制約に当たるので追加しない
+func (r *candidateRepository) ReplacePending(ctx context.Context, kind duplicate.Kind, candidates []*duplicate.Candidate) error {
+	return runInTx(ctx, r.db, func(ctx context.Context) error {
+		db := executor(ctx, r.db)
+		if _, err := db.ExecContext(ctx,
+			`DELETE FROM "duplicate_candidate" WHERE "candidate_kind" = $1 AND "candidate_status" = $2`,
+			string(kind), string(duplicate.StatusPending),
+		); err != nil {
+			return err
+		}
+		for _, c := range candidates {
+			if c.Kind() != kind {
+				continue
+			}
+			_, err := db.ExecContext(ctx, `
+				INSERT INTO "duplicate_candidate" (`+candidateColumns+`, "candidate_score")
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				ON CONFLICT ("candidate_kind", "duplicate_id", "canonical_id") DO NOTHING`,
+				candidateArgs(c)...,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func candidateArgs(c *duplicate.Candidate) []any {
+	reasons := make([]string, 0, len(c.Reasons()))
+	for _, reason := range c.Reasons() {
+		reasons = append(reasons, string(reason))
+	}
+	return []any{
+		c.ID(), string(c.Kind()), c.DuplicateID(), c.CanonicalID(), strings.Join(reasons, ","),
+		string(c.Status()), c.DetectedAt(), c.ResolvedAt(), c.Score(),
+	}
+}
+
+func scanCandidate(s scanner) (*duplicate.Candidate, error) {
+	var (
+		id, kind, duplicateID, canonicalID, reasons, status string
+		detectedAt                                          time.Time
+		resolvedAt                                          sql.NullTime
+	)
+	if err := s.Scan(&id, &kind, &duplicateID, &canonicalID, &reasons, &status, &detectedAt, &resolvedAt); err != nil {
+		return nil, err
+	}
+	var rs []duplicate.Reason
+	for _, reason := range strings.Split(reasons, ",") {
+		rs = append(rs, duplicate.Reason(reason))
+	}
+	return duplicate.Reconstruct(
+		id, duplicate.Kind(kind), duplicateID, canonicalID, rs,
+		duplicate.Status(status), detectedAt, nullTimeToPtr(resolvedAt),
+	)
+}
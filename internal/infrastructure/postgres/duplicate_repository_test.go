@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/duplicate"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+func TestCandidateRepository_ReplacePending(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	c, err := duplicate.NewCandidate(clk, duplicate.KindAuthor, ulid.NewULID(), ulid.NewULID(), []duplicate.Reason{duplicate.ReasonSameName, duplicate.ReasonSameNamePhonic})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "duplicate_candidate" WHERE "candidate_kind" = \$1 AND "candidate_status" = \$2`).
+		WithArgs("author", "pending").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO "duplicate_candidate" .* ON CONFLICT \("candidate_kind", "duplicate_id", "canonical_id"\) DO NOTHING`).
+		WithArgs(c.ID(), "author", c.DuplicateID(), c.CanonicalID(), "same_name,same_name_phonic", "pending", now, nil, 100).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := NewCandidateRepository(db).ReplacePending(context.Background(), duplicate.KindAuthor, []*duplicate.Candidate{c}); err != nil {
+		t.Errorf("ReplacePending() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDuplicateMerger_Merge(t *testing.T) {
+	now := time.Now()
+	clk := clock.NewFixed(now)
+	duplicateID, canonicalID := ulid.NewULID(), ulid.NewULID()
+	c, err := duplicate.NewCandidate(clk, duplicate.KindLabel, duplicateID, canonicalID, []duplicate.Reason{duplicate.ReasonSameName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	columns := []string{"id", "label_name", "label_phonic", "label_add_time", "label_update_time", "label_delete_time"}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .* FROM "book_label" WHERE "id" = \$1`).
+		WithArgs(duplicateID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(duplicateID, "ｼﾞｬﾝﾌﾟ･ｺﾐｯｸｽ", "ジャンプコミックス", now, now, nil))
+	mock.ExpectQuery(`SELECT .* FROM "book_label" WHERE "id" = \$1`).
+		WithArgs(canonicalID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(canonicalID, "ジャンプ・コミックス", "ジャンプコミックス", now, now, nil))
+	mock.ExpectExec(`UPDATE "book" SET "label_id" = \$2`).
+		WithArgs(duplicateID, canonicalID, now).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec(`INSERT INTO "book_label"`).
+		WithArgs(duplicateID, "ｼﾞｬﾝﾌﾟ･ｺﾐｯｸｽ", "ジャンプコミックス", now, now, now, "janpukomikkusu").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "duplicate_candidate"`).
+		WithArgs("label", "pending", c.ID(), duplicateID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO "duplicate_candidate"`).
+		WithArgs(c.ID(), "label", duplicateID, canonicalID, "same_name", "merged", now, now, 80).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := NewDuplicateMerger(db).Merge(context.Background(), clk, c); err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	if c.Status() != duplicate.StatusMerged {
+		t.Errorf("Status() = %s, want = %s", c.Status(), duplicate.StatusMerged)
+	}
+}
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/label"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/pkg/gojuon"
+	"github.com/mitsu-yuki/shisho-backend/pkg/romaji"
+)
+
+type labelRepository struct {
+	db *sql.DB
+}
+
+func NewLabelRepository(db *sql.DB) label.LabelRepository {
+	return &labelRepository{db: db}
+}
+
+const labelColumns = `"id", "label_name", "label_phonic", "label_add_time", "label_update_time", "label_delete_time"`
+
+func (r *labelRepository) Save(ctx context.Context, l *label.Label) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO "book_label" (`+labelColumns+`, "label_romaji")
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT ("id") DO UPDATE SET
+			"label_name" = EXCLUDED."label_name",
+			"label_phonic" = EXCLUDED."label_phonic",
+			"label_update_time" = EXCLUDED."label_update_time",
+			"label_delete_time" = EXCLUDED."label_delete_time",
+			"label_romaji" = EXCLUDED."label_romaji"`,
+		l.ID(), l.Name(), l.NamePhonic(), l.CreateAt(), l.LastUpdateAt(), l.DeletedAt(), l.NameRomaji(),
+	)
+	return err
+}
+
+func (r *labelRepository) FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*label.Label, error) {
+	row := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+labelColumns+` FROM "book_label" WHERE "id" = $1 AND `+notDeleted(`"label_delete_time"`, opts),
+		id,
+	)
+	l, err := scanLabel(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errDomain.ErrNotFound
+	}
+	return l, err
+}
+
+func (r *labelRepository) FindAll(ctx context.Context, opts ...repository.FindOption) ([]*label.Label, error) {
+	return r.query(ctx,
+		`SELECT `+labelColumns+` FROM "book_label" WHERE `+notDeleted(`"label_delete_time"`, opts)+` ORDER BY "id"`,
+	)
+}
+
+func (r *labelRepository) query(ctx context.Context, query string, args ...any) ([]*label.Label, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var labels []*label.Label
+	for rows.Next() {
+		l, err := scanLabel(rows)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, l)
+	}
+	return labels, rows.Err()
+}
+
+// FindAllOrderByReading はDBの照合順序によらず五十音順になるようにアプリケーション側で並べる
+func (r *labelRepository) FindAllOrderByReading(ctx context.Context, opts ...repository.FindOption) ([]*label.Label, error) {
+	labels, err := r.FindAll(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	gojuon.Sort(labels, (*label.Label).NamePhonic)
+	return labels, nil
+}
+
+func (r *labelRepository) FindByIndexRow(ctx context.Context, row gojuon.Row, opts ...repository.FindOption) ([]*label.Label, error) {
+	labels, err := r.FindAllOrderByReading(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(labels, func(l *label.Label) bool {
+		return gojuon.RowOf(l.NamePhonic()) != row
+	}), nil
+}
+
+// FindByRomaji は区切りを除いたローマ字の部分一致で探し、五十音順に並べる
+// 区切りや記号だけの q はすべてに一致してしまうため、何も返さない
+func (r *labelRepository) FindByRomaji(ctx context.Context, q string, opts ...repository.FindOption) ([]*label.Label, error) {
+	query := romaji.NormalizeQuery(q)
+	if query == "" {
+		return nil, nil
+	}
+	labels, err := r.query(ctx,
+		`SELECT `+labelColumns+` FROM "book_label"
+		WHERE REPLACE("label_romaji", ' ', '') LIKE '%' || $1 || '%' AND `+notDeleted(`"label_delete_time"`, opts),
+		query,
+	)
+	if err != nil {
+		return nil, err
+	}
+	gojuon.Sort(labels, (*label.Label).NamePhonic)
+	return labels, nil
+}
+
+func (r *labelRepository) FindBySlug(ctx context.Context, slug string, opts ...repository.FindOption) ([]*label.Label, error) {
+	return r.query(ctx,
+		`SELECT `+labelColumns+` FROM "book_label" WHERE "label_romaji" = $1 AND `+notDeleted(`"label_delete_time"`, opts)+` ORDER BY "id"`,
+		romaji.FromSlug(slug),
+	)
+}
+
+func (r *labelRepository) Delete(ctx context.Context, id string) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx, `DELETE FROM "book_label" WHERE "id" = $1`, id)
+	return err
+}
+
+// Purge は書籍から参照されていないレーベルのみ削除する
+func (r *labelRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	result, err := executor(ctx, r.db).ExecContext(ctx, `
+		DELETE FROM "book_label"
+		WHERE "label_delete_time" < $1
+			AND NOT EXISTS (SELECT 1 FROM "book" WHERE "book"."label_id" = "book_label"."id")`,
+		before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func scanLabel(s scanner) (*label.Label, error) {
+	var (
+		id, name, namePhonic string
+		createAt             time.Time
+		lastUpdateAt         sql.NullTime
+		deletedAt            sql.NullTime
+	)
+	if err := s.Scan(&id, &name, &namePhonic, &createAt, &lastUpdateAt, &deletedAt); err != nil {
+		return nil, err
+	}
+	return label.Reconstruct(id, name, namePhonic, createAt, updateTime(createAt, lastUpdateAt), nullTimeToPtr(deletedAt))
+}
@@ -0,0 +1,136 @@
+package migration
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		fsys    fstest.MapFS
+		want    []Migration
+		wantErr bool
+	}{
+		{
+			name: "正常系: 番号順に並ぶ",
+			fsys: fstest.MapFS{
+				"migrations/0002_second.up.sql":   {Data: []byte("up2")},
+				"migrations/0002_second.down.sql": {Data: []byte("down2")},
+				"migrations/0001_first.up.sql":    {Data: []byte("up1")},
+				"migrations/0001_first.down.sql":  {Data: []byte("down1")},
+				"migrations/README.md":            {Data: []byte("ignored")},
+			},
+			want: []Migration{
+				{Version: 1, Name: "first", up: "up1", down: "down1"},
+				{Version: 2, Name: "second", up: "up2", down: "down2"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "異常系: downがない",
+			fsys: fstest.MapFS{
+				"migrations/0001_first.up.sql": {Data: []byte("up1")},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "異常系: 同じ番号で名前が異なる",
+			fsys: fstest.MapFS{
+				"migrations/0001_first.up.sql":   {Data: []byte("up1")},
+				"migrations/0001_other.down.sql": {Data: []byte("down1")},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := load(tt.fsys, "migrations")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(got, tt.want, cmp.AllowUnexported(Migration{})); diff != "" {
+				t.Errorf("load() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestMigrator_Up(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	migrator := &Migrator{
+		db: db,
+		migrations: []Migration{
+			{Version: 1, Name: "first", up: "CREATE TABLE first", down: "DROP TABLE first"},
+			{Version: 2, Name: "second", up: "CREATE TABLE second", down: "DROP TABLE second"},
+		},
+	}
+
+	mock.ExpectExec(`CREATE TABLE IF NOT EXISTS "schema_migrations"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT "version", "applied_at" FROM "schema_migrations"`).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "applied_at"}).AddRow(1, time.Now()))
+	mock.ExpectBegin()
+	mock.ExpectExec(`CREATE TABLE second`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO "schema_migrations"`).
+		WithArgs(2, "second", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	got, err := migrator.Up(context.Background())
+	if err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Version != 2 {
+		t.Errorf("Up() = %v, want version 2 only", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMigrator_Down(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	migrator := &Migrator{
+		db: db,
+		migrations: []Migration{
+			{Version: 1, Name: "first", up: "CREATE TABLE first", down: "DROP TABLE first"},
+			{Version: 2, Name: "second", up: "CREATE TABLE second", down: "DROP TABLE second"},
+		},
+	}
+
+	mock.ExpectExec(`CREATE TABLE IF NOT EXISTS "schema_migrations"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT "version", "applied_at" FROM "schema_migrations"`).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "applied_at"}).AddRow(1, time.Now()).AddRow(2, time.Now()))
+	mock.ExpectBegin()
+	mock.ExpectExec(`DROP TABLE second`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "schema_migrations"`).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	got, err := migrator.Down(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Version != 2 {
+		t.Errorf("Down() = %v, want version 2 only", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/publish"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/pkg/gojuon"
+	"github.com/mitsu-yuki/shisho-backend/pkg/romaji"
+)
+
+type publishRepository struct {
+	db *sql.DB
+}
+
+func NewPublishRepository(db *sql.DB) publish.PublishRepository {
+	return &publishRepository{db: db}
+}
+
+const publishColumns = `"id", "publish_name", "publish_name_phonic", "publish_add_time", "publish_update_time", "publish_delete_time"`
+
+func (r *publishRepository) Save(ctx context.Context, p *publish.Publish) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO "publish" (`+publishColumns+`, "publish_name_romaji")
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT ("id") DO UPDATE SET
+			"publish_name" = EXCLUDED."publish_name",
+			"publish_name_phonic" = EXCLUDED."publish_name_phonic",
+			"publish_update_time" = EXCLUDED."publish_update_time",
+			"publish_delete_time" = EXCLUDED."publish_delete_time",
+			"publish_name_romaji" = EXCLUDED."publish_name_romaji"`,
+		p.ID(), p.Name(), p.NamePhonic(), p.CreateAt(), p.LastUpdateAt(), p.DeletedAt(), p.NameRomaji(),
+	)
+	return err
+}
+
+func (r *publishRepository) FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*publish.Publish, error) {
+	row := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+publishColumns+` FROM "publish" WHERE "id" = $1 AND `+notDeleted(`"publish_delete_time"`, opts),
+		id,
+	)
+	p, err := scanPublish(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errDomain.ErrNotFound
+	}
+	return p, err
+}
+
+func (r *publishRepository) FindAll(ctx context.Context, opts ...repository.FindOption) ([]*publish.Publish, error) {
+	return r.query(ctx,
+		`SELECT `+publishColumns+` FROM "publish" WHERE `+notDeleted(`"publish_delete_time"`, opts)+` ORDER BY "id"`,
+	)
+}
+
+func (r *publishRepository) query(ctx context.Context, query string, args ...any) ([]*publish.Publish, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var publishes []*publish.Publish
+	for rows.Next() {
+		p, err := scanPublish(rows)
+		if err != nil {
+			return nil, err
+		}
+		publishes = append(publishes, p)
+	}
+	return publishes, rows.Err()
+}
+
+// FindAllOrderByReading はDBの照合順序によらず五十音順になるようにアプリケーション側で並べる
+func (r *publishRepository) FindAllOrderByReading(ctx context.Context, opts ...repository.FindOption) ([]*publish.Publish, error) {
+	publishes, err := r.FindAll(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	gojuon.Sort(publishes, (*publish.Publish).NamePhonic)
+	return publishes, nil
+}
+
+func (r *publishRepository) FindByIndexRow(ctx context.Context, row gojuon.Row, opts ...repository.FindOption) ([]*publish.Publish, error) {
+	publishes, err := r.FindAllOrderByReading(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(publishes, func(p *publish.Publish) bool {
+		return gojuon.RowOf(p.NamePhonic()) != row
+	}), nil
+}
+
+// FindByRomaji は区切りを除いたローマ字の部分一致で探し、五十音順に並べる
+// 区切りや記号だけの q はすべてに一致してしまうため、何も返さない
+func (r *publishRepository) FindByRomaji(ctx context.Context, q string, opts ...repository.FindOption) ([]*publish.Publish, error) {
+	query := romaji.NormalizeQuery(q)
+	if query == "" {
+		return nil, nil
+	}
+	publishes, err := r.query(ctx,
+		`SELECT `+publishColumns+` FROM "publish"
+		WHERE REPLACE("publish_name_romaji", ' ', '') LIKE '%' || $1 || '%' AND `+notDeleted(`"publish_delete_time"`, opts),
+		query,
+	)
+	if err != nil {
+		return nil, err
+	}
+	gojuon.Sort(publishes, (*publish.Publish).NamePhonic)
+	return publishes, nil
+}
+
+func (r *publishRepository) FindBySlug(ctx context.Context, slug string, opts ...repository.FindOption) ([]*publish.Publish, error) {
+	return r.query(ctx,
+		`SELECT `+publishColumns+` FROM "publish" WHERE "publish_name_romaji" = $1 AND `+notDeleted(`"publish_delete_time"`, opts)+` ORDER BY "id"`,
+		romaji.FromSlug(slug),
+	)
+}
+
+func (r *publishRepository) Delete(ctx context.Context, id string) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx, `DELETE FROM "publish" WHERE "id" = $1`, id)
+	return err
+}
+
+// Purge は書籍から参照されていない出版社のみ削除する
+func (r *publishRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	result, err := executor(ctx, r.db).ExecContext(ctx, `
+		DELETE FROM "publish"
+		WHERE "publish_delete_time" < $1
+			AND NOT EXISTS (SELECT 1 FROM "book" WHERE "book"."publish_id" = "publish"."id")`,
+		before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func scanPublish(s scanner) (*publish.Publish, error) {
+	var (
+		id, name, namePhonic string
+		createAt             time.Time
+		lastUpdateAt         sql.NullTime
+		deletedAt            sql.NullTime
+	)
+	if err := s.Scan(&id, &name, &namePhonic, &createAt, &lastUpdateAt, &deletedAt); err != nil {
+		return nil, err
+	}
+	return publish.Reconstruct(id, name, namePhonic, createAt, updateTime(createAt, lastUpdateAt), nullTimeToPtr(deletedAt))
+}
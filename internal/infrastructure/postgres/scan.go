@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+)
+
+// scanner は*sql.Rowと*sql.Rowsの共通部分
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// updateTime は更新日時がNULLの行を作成日時で補う
+func updateTime(createAt time.Time, lastUpdateAt sql.NullTime) time.Time {
+	if !lastUpdateAt.Valid {
+		return createAt
+	}
+	return lastUpdateAt.Time
+}
+
+func nullTimeToPtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func nullStringToPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+// placeholders は $start から n 個のプレースホルダをカンマ区切りで返す
+func placeholders(start, n int) string {
+	ps := make([]string, 0, n)
+	for i := start; i < start+n; i++ {
+		ps = append(ps, "$"+strconv.Itoa(i))
+	}
+	return strings.Join(ps, ", ")
+}
+
+// notDeleted は論理削除された行を除く条件を返す
+// WithDeleted が指定された場合はすべての行を対象にする
+func notDeleted(column string, opts []repository.FindOption) string {
+	if repository.NewFindOptions(opts...).IncludeDeleted {
+		return "TRUE"
+	}
+	return column + ` IS NULL`
+}
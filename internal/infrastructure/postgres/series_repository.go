@@ -0,0 +1,233 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/series"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/seriesstatus"
+)
+
+type seriesRepository struct {
+	db *sql.DB
+}
+
+func NewSeriesRepository(db *sql.DB) series.SeriesRepository {
+	return &seriesRepository{db: db}
+}
+
+const seriesColumns = `"id", "series_name", "status_id", "series_title_add_time", "series_title_update_time", "series_title_delete_time"`
+
+// seriesSelect はステータスを名前で引けるようにseries_statusを結合する
+const seriesSelect = `
+	SELECT t."id", t."series_name", s."status", t."series_title_add_time", t."series_title_update_time", t."series_title_delete_time"
+	FROM "series_title" t
+	JOIN "series_status" s ON s."id" = t."status_id"`
+
+func (r *seriesRepository) Save(ctx context.Context, s *series.Series) error {
+	return runInTx(ctx, r.db, func(ctx context.Context) error {
+		db := executor(ctx, r.db)
+		statusID, err := r.statusID(ctx, s.Status())
+		if err != nil {
+			return err
+		}
+		_, err = db.ExecContext(ctx, `
+			INSERT INTO "series_title" (`+seriesColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT ("id") DO UPDATE SET
+				"series_name" = EXCLUDED."series_name",
+				"status_id" = EXCLUDED."status_id",
+				"series_title_update_time" = EXCLUDED."series_title_update_time",
+				"series_title_delete_time" = EXCLUDED."series_title_delete_time"`,
+			s.ID(), s.Name(), statusID, s.CreateAt(), s.LastUpdateAt(), s.DeletedAt(),
+		)
+		if err != nil {
+			return err
+		}
+
+		// 作品リストは洗い替える
+		if _, err := db.ExecContext(ctx, `DELETE FROM "series_list" WHERE "title_id" = $1`, s.ID()); err != nil {
+			return err
+		}
+		for _, b := range s.Books() {
+			_, err := db.ExecContext(ctx, `
+				INSERT INTO "series_list" ("title_id", "part_number", "book_id", "series_list_add_time", "series_list_update_time")
+				VALUES ($1, $2, $3, $4, $4)`,
+				s.ID(), b.Volume().String(), b.BookID(), s.LastUpdateAt(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// statusID はステータスの行のIDを返す
+// 未登録のステータスのまま保存すると NOT NULL 制約のエラーになるため、先に引いてドメインのエラーにする
+func (r *seriesRepository) statusID(ctx context.Context, status seriesstatus.Status) (string, error) {
+	var id string
+	err := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT "id" FROM "series_status" WHERE "status" = $1`, status.String(),
+	).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", series.ErrStatusNotFound
+	}
+	return id, err
+}
+
+func (r *seriesRepository) FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*series.Series, error) {
+	db := executor(ctx, r.db)
+	row, err := scanSeriesRow(db.QueryRowContext(ctx,
+		seriesSelect+` WHERE t."id" = $1 AND `+notDeleted(`t."series_title_delete_time"`, opts),
+		id,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errDomain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	books, err := r.findBooks(ctx, `WHERE "title_id" = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	return row.toSeries(books[id])
+}
+
+func (r *seriesRepository) FindAll(ctx context.Context, opts ...repository.FindOption) ([]*series.Series, error) {
+	db := executor(ctx, r.db)
+	rows, err := db.QueryContext(ctx,
+		seriesSelect+` WHERE `+notDeleted(`t."series_title_delete_time"`, opts)+` ORDER BY t."id"`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var seriesRows []*seriesRow
+	for rows.Next() {
+		row, err := scanSeriesRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		seriesRows = append(seriesRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	books, err := r.findBooks(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	seriesList := make([]*series.Series, 0, len(seriesRows))
+	for _, row := range seriesRows {
+		s, err := row.toSeries(books[row.id])
+		if err != nil {
+			return nil, err
+		}
+		seriesList = append(seriesList, s)
+	}
+	return seriesList, nil
+}
+
+func (r *seriesRepository) Delete(ctx context.Context, id string) error {
+	return runInTx(ctx, r.db, func(ctx context.Context) error {
+		db := executor(ctx, r.db)
+		if _, err := db.ExecContext(ctx, `DELETE FROM "series_list" WHERE "title_id" = $1`, id); err != nil {
+			return err
+		}
+		_, err := db.ExecContext(ctx, `DELETE FROM "series_title" WHERE "id" = $1`, id)
+		return err
+	})
+}
+
+// Purge は作品リストを外してからシリーズを削除する
+func (r *seriesRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	err := runInTx(ctx, r.db, func(ctx context.Context) error {
+		db := executor(ctx, r.db)
+		_, err := db.ExecContext(ctx, `
+			DELETE FROM "series_list"
+			WHERE "title_id" IN (SELECT "id" FROM "series_title" WHERE "series_title_delete_time" < $1)`,
+			before,
+		)
+		if err != nil {
+			return err
+		}
+		result, err := db.ExecContext(ctx, `DELETE FROM "series_title" WHERE "series_title_delete_time" < $1`, before)
+		if err != nil {
+			return err
+		}
+		purged, err = result.RowsAffected()
+		return err
+	})
+	return purged, err
+}
+
+// findBooks はシリーズIDごとの作品リストを返す
+// 巻数の並べ替えはドメインで行う
+func (r *seriesRepository) findBooks(ctx context.Context, where string, args ...any) (map[string][]series.SeriesBook, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT "title_id", "book_id", "part_number" FROM "series_list" `+where+` ORDER BY "title_id", "id"`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books := map[string][]series.SeriesBook{}
+	for rows.Next() {
+		var seriesID, bookID, partNumber string
+		if err := rows.Scan(&seriesID, &bookID, &partNumber); err != nil {
+			return nil, err
+		}
+		volume, err := series.NewVolume(partNumber)
+		if err != nil {
+			return nil, err
+		}
+		books[seriesID] = append(books[seriesID], series.NewSeriesBook(bookID, volume))
+	}
+	return books, rows.Err()
+}
+
+type seriesRow struct {
+	id           string
+	name         string
+	status       string
+	createAt     time.Time
+	lastUpdateAt sql.NullTime
+	deletedAt    sql.NullTime
+}
+
+func scanSeriesRow(s scanner) (*seriesRow, error) {
+	var row seriesRow
+	if err := s.Scan(&row.id, &row.name, &row.status, &row.createAt, &row.lastUpdateAt, &row.deletedAt); err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (row *seriesRow) toSeries(books []series.SeriesBook) (*series.Series, error) {
+	status, err := seriesstatus.NewStatus(row.status)
+	if err != nil {
+		return nil, err
+	}
+	return series.Reconstruct(
+		row.id,
+		row.name,
+		books,
+		status,
+		row.createAt,
+		updateTime(row.createAt, row.lastUpdateAt),
+		nullTimeToPtr(row.deletedAt),
+	)
+}
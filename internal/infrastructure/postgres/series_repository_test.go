@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/series"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/seriesstatus"
+	"github.com/mitsu-yuki/shisho-backend/pkg/clock"
+	"github.com/mitsu-yuki/shisho-backend/pkg/ulid"
+)
+
+func TestSeriesRepository_FindAll(t *testing.T) {
+	id1 := ulid.NewULID()
+	id2 := ulid.NewULID()
+	bookID1 := ulid.NewULID()
+	bookID2 := ulid.NewULID()
+	bookID3 := ulid.NewULID()
+	now := time.Now()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectQuery(`SELECT .* FROM "series_title" t\s+JOIN "series_status" s ON s."id" = t."status_id" WHERE t."series_title_delete_time" IS NULL ORDER BY t."id"`).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "series_name", "status", "series_title_add_time", "series_title_update_time", "series_title_delete_time"}).
+			AddRow(id1, "シリーズ1", "連載中", now, now, nil).
+			AddRow(id2, "シリーズ2", "連載中", now, nil, nil),
+	)
+	mock.ExpectQuery(`SELECT "title_id", "book_id", "part_number" FROM "series_list"`).WillReturnRows(
+		sqlmock.NewRows([]string{"title_id", "book_id", "part_number"}).
+			AddRow(id1, bookID2, "2").
+			AddRow(id1, bookID1, "1").
+			AddRow(id2, bookID3, "外伝"),
+	)
+
+	got, err := NewSeriesRepository(db).FindAll(context.Background())
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	want := []*series.Series{
+		mustSeries(t, id1, "シリーズ1", []series.SeriesBook{series.NewSeriesBook(bookID1, mustVolume(t, "1")), series.NewSeriesBook(bookID2, mustVolume(t, "2"))}, seriesstatus.Ongoing, now),
+		mustSeries(t, id2, "シリーズ2", []series.SeriesBook{series.NewSeriesBook(bookID3, mustVolume(t, "外伝"))}, seriesstatus.Ongoing, now),
+	}
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(series.Series{}, series.SeriesBook{}, series.Volume{})); diff != "" {
+		t.Errorf("FindAll() = %v, want = %v.\n error is %s", got, want, diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func mustSeries(t *testing.T, id, name string, books []series.SeriesBook, status seriesstatus.Status, now time.Time) *series.Series {
+	t.Helper()
+	s, err := series.Reconstruct(id, name, books, status, now, now, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func mustVolume(t *testing.T, s string) series.Volume {
+	t.Helper()
+	v, err := series.NewVolume(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func newTestSeries(t *testing.T, clk clock.Clock, bookID string) *series.Series {
+	t.Helper()
+	volume, err := series.NewVolume("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := series.NewSeries(clk, "シリーズ", []series.SeriesBook{series.NewSeriesBook(bookID, volume)}, seriesstatus.Ongoing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSeriesRepository_Save(t *testing.T) {
+	now := time.Now()
+	bookID := ulid.NewULID()
+	statusID := ulid.NewULID()
+	s := newTestSeries(t, clock.NewFixed(now), bookID)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "series_status" WHERE "status" = \$1`).WithArgs("連載中").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(statusID))
+	mock.ExpectExec(`INSERT INTO "series_title"`).
+		WithArgs(s.ID(), "シリーズ", statusID, now, now, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "series_list" WHERE "title_id" = \$1`).WithArgs(s.ID()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO "series_list"`).WithArgs(s.ID(), "1", bookID, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := NewSeriesRepository(db).Save(context.Background(), s); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSeriesRepository_Save_StatusNotFound(t *testing.T) {
+	s := newTestSeries(t, clock.NewFixed(time.Now()), ulid.NewULID())
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "id" FROM "series_status" WHERE "status" = \$1`).WithArgs("連載中").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	if err := NewSeriesRepository(db).Save(context.Background(), s); !errors.Is(err, series.ErrStatusNotFound) {
+		t.Errorf("Save() error = %v, want %v", err, series.ErrStatusNotFound)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/size"
+)
+
+type sizeRepository struct {
+	db *sql.DB
+}
+
+func NewSizeRepository(db *sql.DB) size.SizeRepository {
+	return &sizeRepository{db: db}
+}
+
+const sizeColumns = `"id", "size_name", "size_add_time", "size_update_time", "size_delete_time"`
+
+func (r *sizeRepository) Save(ctx context.Context, s *size.Size) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO "book_size" (`+sizeColumns+`)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT ("id") DO UPDATE SET
+			"size_name" = EXCLUDED."size_name",
+			"size_update_time" = EXCLUDED."size_update_time",
+			"size_delete_time" = EXCLUDED."size_delete_time"`,
+		s.ID(), s.Name(), s.CreateAt(), s.LastUpdateAt(), s.DeletedAt(),
+	)
+	return err
+}
+
+func (r *sizeRepository) FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*size.Size, error) {
+	row := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+sizeColumns+` FROM "book_size" WHERE "id" = $1 AND `+notDeleted(`"size_delete_time"`, opts),
+		id,
+	)
+	s, err := scanSize(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errDomain.ErrNotFound
+	}
+	return s, err
+}
+
+func (r *sizeRepository) FindAll(ctx context.Context, opts ...repository.FindOption) ([]*size.Size, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT `+sizeColumns+` FROM "book_size" WHERE `+notDeleted(`"size_delete_time"`, opts)+` ORDER BY "id"`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sizes []*size.Size
+	for rows.Next() {
+		s, err := scanSize(rows)
+		if err != nil {
+			return nil, err
+		}
+		sizes = append(sizes, s)
+	}
+	return sizes, rows.Err()
+}
+
+func (r *sizeRepository) Delete(ctx context.Context, id string) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx, `DELETE FROM "book_size" WHERE "id" = $1`, id)
+	return err
+}
+
+// Purge は書籍から参照されていない判型のみ削除する
+func (r *sizeRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	result, err := executor(ctx, r.db).ExecContext(ctx, `
+		DELETE FROM "book_size"
+		WHERE "size_delete_time" < $1
+			AND NOT EXISTS (SELECT 1 FROM "book" WHERE "book"."size_id" = "book_size"."id")`,
+		before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func scanSize(s scanner) (*size.Size, error) {
+	var (
+		id, name     string
+		createAt     time.Time
+		lastUpdateAt sql.NullTime
+		deletedAt    sql.NullTime
+	)
+	if err := s.Scan(&id, &name, &createAt, &lastUpdateAt, &deletedAt); err != nil {
+		return nil, err
+	}
+	return size.Reconstruct(id, name, createAt, updateTime(createAt, lastUpdateAt), nullTimeToPtr(deletedAt))
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	errDomain "github.com/mitsu-yuki/shisho-backend/internal/domain/error"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/repository"
+	"github.com/mitsu-yuki/shisho-backend/internal/domain/tag"
+)
+
+type tagRepository struct {
+	db *sql.DB
+}
+
+func NewTagRepository(db *sql.DB) tag.TagRepository {
+	return &tagRepository{db: db}
+}
+
+const tagColumns = `"id", "tag_name", "tag_add_time", "tag_update_time", "tag_delete_time"`
+
+func (r *tagRepository) Save(ctx context.Context, t *tag.Tag) error {
+	_, err := executor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO "tag" (`+tagColumns+`)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT ("id") DO UPDATE SET
+			"tag_name" = EXCLUDED."tag_name",
+			"tag_update_time" = EXCLUDED."tag_update_time",
+			"tag_delete_time" = EXCLUDED."tag_delete_time"`,
+		t.ID(), t.Name(), t.CreateAt(), t.LastUpdateAt(), t.DeletedAt(),
+	)
+	return err
+}
+
+func (r *tagRepository) FindByID(ctx context.Context, id string, opts ...repository.FindOption) (*tag.Tag, error) {
+	row := executor(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+tagColumns+` FROM "tag" WHERE "id" = $1 AND `+notDeleted(`"tag_delete_time"`, opts),
+		id,
+	)
+	t, err := scanTag(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errDomain.ErrNotFound
+	}
+	return t, err
+}
+
+func (r *tagRepository) FindAll(ctx context.Context, opts ...repository.FindOption) ([]*tag.Tag, error) {
+	rows, err := executor(ctx, r.db).QueryContext(ctx,
+		`SELECT `+tagColumns+` FROM "tag" WHERE `+notDeleted(`"tag_delete_time"`, opts)+` ORDER BY "id"`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []*tag.Tag
+	for rows.Next() {
+		t, err := scanTag(rows)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+	return tags, rows.Err()
+}
+
+func (r *tagRepository) Delete(ctx context.Context, id string) error {
+	return runInTx(ctx, r.db, func(ctx context.Context) error {
+		db := executor(ctx, r.db)
+		if _, err := db.ExecContext(ctx, `DELETE FROM "tag_list" WHERE "tag_id" = $1`, id); err != nil {
+			return err
+		}
+		_, err := db.ExecContext(ctx, `DELETE FROM "tag" WHERE "id" = $1`, id)
+		return err
+	})
+}
+
+// Purge はタグを外してから削除する
+func (r *tagRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	err := runInTx(ctx, r.db, func(ctx context.Context) error {
+		db := executor(ctx, r.db)
+		_, err := db.ExecContext(ctx, `
+			DELETE FROM "tag_list"
+			WHERE "tag_id" IN (SELECT "id" FROM "tag" WHERE "tag_delete_time" < $1)`,
+			before,
+		)
+		if err != nil {
+			return err
+		}
+		result, err := db.ExecContext(ctx, `DELETE FROM "tag" WHERE "tag_delete_time" < $1`, before)
+		if err != nil {
+			return err
+		}
+		purged, err = result.RowsAffected()
+		return err
+	})
+	return purged, err
+}
+
+func scanTag(s scanner) (*tag.Tag, error) {
+	var (
+		id, name     string
+		createAt     time.Time
+		lastUpdateAt sql.NullTime
+		deletedAt    sql.NullTime
+	)
+	if err := s.Scan(&id, &name, &createAt, &lastUpdateAt, &deletedAt); err != nil {
+		return nil, err
+	}
+	return tag.Reconstruct(id, name, createAt, updateTime(createAt, lastUpdateAt), nullTimeToPtr(deletedAt))
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+)
+
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txKey struct{}
+
+// Transaction はcontextにトランザクションを載せ、同一contextを受け取ったリポジトリで共有させる
+type Transaction struct {
+	db *sql.DB
+}
+
+func NewTransaction(db *sql.DB) *Transaction {
+	return &Transaction{db: db}
+}
+
+func (t *Transaction) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return runInTx(ctx, t.db, fn)
+}
+
+// runInTx はcontextにトランザクションがあればそれを使い、なければ新しく開始する
+func runInTx(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// executor はcontextにトランザクションがあればそれを、なければDBを返す
+func executor(ctx context.Context, db *sql.DB) dbtx {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
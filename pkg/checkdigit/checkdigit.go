@@ -5,3 +5,38 @@ import "github.com/osamingo/checkdigit"
 func ISBN13IsValid(s string) bool {
 	return checkdigit.NewISBN13().Verify(s)
 }
+
+// ISBN10IsValid はチェックディジットにXを含むISBN-10も受け付ける
+func ISBN10IsValid(s string) bool {
+	return checkdigit.NewISBN10().Verify(s)
+}
+
+// ISBN13CheckDigit は12桁の seed に対するISBN-13のチェックディジットを返す
+func ISBN13CheckDigit(seed string) (byte, error) {
+	d, err := checkdigit.NewISBN13().Generate(seed)
+	if err != nil {
+		return 0, err
+	}
+	return byte('0' + d), nil
+}
+
+// ISBN10CheckDigit は9桁の seed に対するISBN-10のチェックディジットを返す
+func ISBN10CheckDigit(seed string) (byte, error) {
+	d, err := checkdigit.NewISBN10().Generate(seed)
+	if err != nil {
+		return 0, err
+	}
+	// Generate は剰余が0のとき11を、10のときXの代わりに10を返す
+	switch d {
+	case 11:
+		return '0', nil
+	case 10:
+		return 'X', nil
+	}
+	return byte('0' + d), nil
+}
+
+// JAN13IsValid は13桁のJANコード(EAN-13)のチェックディジットを検証する
+func JAN13IsValid(s string) bool {
+	return checkdigit.NewJAN13().Verify(s)
+}
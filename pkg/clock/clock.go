@@ -0,0 +1,35 @@
+package clock
+
+import "time"
+
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func New() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// FixedClock は常に同じ時刻を返す。テストで時刻を固定するために使う
+type FixedClock struct {
+	now time.Time
+}
+
+func NewFixed(now time.Time) *FixedClock {
+	return &FixedClock{now: now}
+}
+
+func (c *FixedClock) Now() time.Time {
+	return c.now
+}
+
+// Advance は時刻をdだけ進める
+func (c *FixedClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
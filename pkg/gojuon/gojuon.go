@@ -0,0 +1,231 @@
+// Package gojuon はカタカナの読みを五十音順に並べる
+// 国語辞典と同じく、清音に直した読みで比べてから濁音、小書き、長音符の順に比べる
+package gojuon
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/mitsu-yuki/shisho-backend/pkg/text"
+)
+
+// Row は五十音の索引の行
+type Row string
+
+const (
+	RowA     Row = "あ"
+	RowKa    Row = "か"
+	RowSa    Row = "さ"
+	RowTa    Row = "た"
+	RowNa    Row = "な"
+	RowHa    Row = "は"
+	RowMa    Row = "ま"
+	RowYa    Row = "や"
+	RowRa    Row = "ら"
+	RowWa    Row = "わ"
+	RowOther Row = "その他"
+)
+
+// Rows は索引の行を表示する順に並べたもの
+var Rows = []Row{RowA, RowKa, RowSa, RowTa, RowNa, RowHa, RowMa, RowYa, RowRa, RowWa, RowOther}
+
+// rowKana は各行に含まれる清音で、Rows と同じ順に並べる
+var rowKana = []string{
+	"アイウエオ",
+	"カキクケコ",
+	"サシスセソ",
+	"タチツテト",
+	"ナニヌネノ",
+	"ハヒフヘホ",
+	"マミムメモ",
+	"ヤユヨ",
+	"ラリルレロ",
+	"ワヰヱヲン",
+}
+
+// vowelColumns は長音符を直前の文字の母音に置き換えるための段
+var vowelColumns = map[rune]string{
+	'ア': "アカサタナハマヤラワ",
+	'イ': "イキシチニヒミリヰ",
+	'ウ': "ウクスツヌフムユル",
+	'エ': "エケセテネヘメレヱ",
+	'オ': "オコソトノホモヨロヲ",
+}
+
+// smallKana は小書きのカナと対応する大きいカナ
+var smallKana = map[rune]rune{
+	'ァ': 'ア', 'ィ': 'イ', 'ゥ': 'ウ', 'ェ': 'エ', 'ォ': 'オ',
+	'ヵ': 'カ', 'ヶ': 'ケ', 'ッ': 'ツ',
+	'ャ': 'ヤ', 'ュ': 'ユ', 'ョ': 'ヨ', 'ヮ': 'ワ',
+}
+
+const (
+	// 清音、濁音、半濁音の順に並べる
+	voiceNone = iota
+	voiced
+	semiVoiced
+)
+
+// otherBase はカナ以外の文字をカナより後に並べるための重み
+const otherBase = 0x110000
+
+// weight は1文字分の比較の重み
+type weight struct {
+	// base は清音に直した大きいカナ
+	base rune
+	// voice は清音、濁音、半濁音の区別
+	voice int
+	// small は小書きのカナか。小書きを先に並べる
+	small bool
+	// long は長音符を母音に置き換えたものか。長音符を先に並べる
+	long bool
+}
+
+// Compare は読みを五十音順で比べる
+// 読みは text.NormalizeReading でカタカナに揃えてから比べる
+func Compare(a, b string) int {
+	wa, wb := weights(text.NormalizeReading(a)), weights(text.NormalizeReading(b))
+	levels := []func(x, y weight) int{
+		func(x, y weight) int { return cmp.Compare(x.base, y.base) },
+		func(x, y weight) int { return cmp.Compare(x.voice, y.voice) },
+		func(x, y weight) int { return -compareBool(x.small, y.small) },
+		func(x, y weight) int { return -compareBool(x.long, y.long) },
+	}
+	for _, level := range levels {
+		if c := slices.CompareFunc(wa, wb, level); c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// RowOf は読みの先頭の文字から索引の行を返す
+// 先頭がカナでない場合は RowOther を返す
+func RowOf(reading string) Row {
+	ws := weights(text.NormalizeReading(reading))
+	if len(ws) == 0 {
+		return RowOther
+	}
+	for i, kana := range rowKana {
+		if strings.ContainsRune(kana, ws[0].base) {
+			return Rows[i]
+		}
+	}
+	return RowOther
+}
+
+// ParseRow は "か" や "カ" のような行の名前を Row に変換する
+func ParseRow(s string) (Row, bool) {
+	if Row(s) == RowOther {
+		return RowOther, true
+	}
+	for i, kana := range rowKana {
+		if text.NormalizeReading(s) == kana[:len("ア")] {
+			return Rows[i], true
+		}
+	}
+	return "", false
+}
+
+// Sort は読みの五十音順に並べ替える
+func Sort[T any](items []T, reading func(T) string) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		return Compare(reading(a), reading(b))
+	})
+}
+
+// Section は索引の1行分の項目
+type Section[T any] struct {
+	Row   Row
+	Items []T
+}
+
+// Group は五十音順に並べ替えて索引の行ごとにまとめる
+// 項目のない行は含めない
+func Group[T any](items []T, reading func(T) string) []Section[T] {
+	sorted := slices.Clone(items)
+	Sort(sorted, reading)
+	byRow := map[Row][]T{}
+	for _, item := range sorted {
+		row := RowOf(reading(item))
+		byRow[row] = append(byRow[row], item)
+	}
+	var sections []Section[T]
+	for _, row := range Rows {
+		if len(byRow[row]) > 0 {
+			sections = append(sections, Section[T]{Row: row, Items: byRow[row]})
+		}
+	}
+	return sections
+}
+
+// weights は読みを比較の重みに変換する
+// 中黒や空白などの記号は無視する
+func weights(reading string) []weight {
+	var ws []weight
+	for _, r := range reading {
+		switch {
+		case r == 'ー':
+			if len(ws) == 0 {
+				continue
+			}
+			if v, ok := vowelOf(ws[len(ws)-1].base); ok {
+				ws = append(ws, weight{base: v, long: true})
+			}
+		case r == 'ヽ' || r == 'ヾ':
+			// 踊り字は直前の文字を繰り返す
+			if len(ws) == 0 {
+				continue
+			}
+			w := weight{base: ws[len(ws)-1].base}
+			if r == 'ヾ' {
+				w.voice = voiced
+			}
+			ws = append(ws, w)
+		case r >= 'ァ' && r <= 'ヺ':
+			ws = append(ws, kanaWeight(r))
+		case r == '・' || r == '＝' || r == '　':
+		default:
+			ws = append(ws, weight{base: otherBase + r})
+		}
+	}
+	return ws
+}
+
+func kanaWeight(r rune) weight {
+	if large, ok := smallKana[r]; ok {
+		return weight{base: large, small: true}
+	}
+	switch {
+	case r >= 'ハ' && r <= 'ポ':
+		offset := (r - 'ハ') % 3
+		return weight{base: r - offset, voice: int(offset)}
+	case strings.ContainsRune("カキクケコサシスセソタチツテト", r-1):
+		return weight{base: r - 1, voice: voiced}
+	case r == 'ヴ':
+		return weight{base: 'ウ', voice: voiced}
+	case r >= 'ヷ' && r <= 'ヺ':
+		return weight{base: r - 'ヷ' + 'ワ', voice: voiced}
+	}
+	return weight{base: r}
+}
+
+func vowelOf(r rune) (rune, bool) {
+	for vowel, column := range vowelColumns {
+		if strings.ContainsRune(column, r) {
+			return vowel, true
+		}
+	}
+	return 0, false
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+	return -1
+}
@@ -0,0 +1,128 @@
+package gojuon
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		readings []string
+		want     []string
+	}{
+		{
+			name:     "正常系: 五十音順",
+			readings: []string{"ソウセキ", "アクタガワ", "ダザイ", "カワバタ"},
+			want:     []string{"アクタガワ", "カワバタ", "ソウセキ", "ダザイ"},
+		},
+		{
+			name:     "正常系: 清音、濁音、半濁音の順",
+			readings: []string{"パパ", "ババ", "ハハ"},
+			want:     []string{"ハハ", "ババ", "パパ"},
+		},
+		{
+			name:     "正常系: 濁音より後の文字を先に比べる",
+			readings: []string{"カキ", "ガ", "カ"},
+			want:     []string{"カ", "ガ", "カキ"},
+		},
+		{
+			name:     "正常系: 小書きを先に並べる",
+			readings: []string{"シヨウ", "ショウ"},
+			want:     []string{"ショウ", "シヨウ"},
+		},
+		{
+			name:     "正常系: 長音符は直前の母音として比べ、先に並べる",
+			readings: []string{"ケエキ", "ケイ", "ケーキ"},
+			want:     []string{"ケイ", "ケーキ", "ケエキ"},
+		},
+		{
+			name:     "正常系: ひらがなと半角カナも比べられる",
+			readings: []string{"ｳｴﾉ", "いとう", "アオキ"},
+			want:     []string{"アオキ", "いとう", "ｳｴﾉ"},
+		},
+		{
+			name:     "正常系: 中黒と空白は無視する",
+			readings: []string{"ジョージ・オーウェル", "ジョージア", "ジョージ　アダムス"},
+			want:     []string{"ジョージア", "ジョージ　アダムス", "ジョージ・オーウェル"},
+		},
+		{
+			name:     "正常系: カナ以外は後ろに並べる",
+			readings: []string{"ABC", "ン", "ア"},
+			want:     []string{"ア", "ン", "ABC"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := append([]string{}, tt.readings...)
+			Sort(got, func(s string) string { return s })
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("Sort() = %v, want = %v.\n error is %s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestRowOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		reading string
+		want    Row
+	}{
+		{name: "正常系", reading: "アオキ", want: RowA},
+		{name: "正常系: 濁音は清音の行", reading: "ガッコウ", want: RowKa},
+		{name: "正常系: 半濁音とひらがな", reading: "ぱん", want: RowHa},
+		{name: "正常系: ヴはア行", reading: "ヴァイオリン", want: RowA},
+		{name: "正常系: 小書きは大きいカナの行", reading: "ャ", want: RowYa},
+		{name: "正常系: 半角カナ", reading: "ﾜﾀﾅﾍﾞ", want: RowWa},
+		{name: "正常系: ンはワ行", reading: "ンジャメナ", want: RowWa},
+		{name: "正常系: 先頭の空白は無視する", reading: " ラ", want: RowRa},
+		{name: "異常系: カナ以外", reading: "123", want: RowOther},
+		{name: "異常系: 長音符だけ", reading: "ー", want: RowOther},
+		{name: "異常系: 空文字", reading: "", want: RowOther},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RowOf(tt.reading); got != tt.want {
+				t.Errorf("RowOf(%q) = %v, want = %v", tt.reading, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRow(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		want   Row
+		wantOK bool
+	}{
+		{name: "正常系: ひらがな", s: "か", want: RowKa, wantOK: true},
+		{name: "正常系: カタカナ", s: "ナ", want: RowNa, wantOK: true},
+		{name: "正常系: 半角カナ", s: "ﾔ", want: RowYa, wantOK: true},
+		{name: "正常系: その他", s: "その他", want: RowOther, wantOK: true},
+		{name: "異常系: 行の先頭でない", s: "き", want: "", wantOK: false},
+		{name: "異常系: カナ以外", s: "x", want: "", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseRow(tt.s)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("ParseRow(%q) = %v, %v, want = %v, %v", tt.s, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestGroup(t *testing.T) {
+	got := Group([]string{"サトウ", "アオキ", "123", "イトウ", "スズキ"}, func(s string) string { return s })
+	want := []Section[string]{
+		{Row: RowA, Items: []string{"アオキ", "イトウ"}},
+		{Row: RowSa, Items: []string{"サトウ", "スズキ"}},
+		{Row: RowOther, Items: []string{"123"}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Group() = %v, want = %v.\n error is %s", got, want, diff)
+	}
+}
@@ -0,0 +1,188 @@
+// Package romaji はカタカナの読みをヘボン式のローマ字に変換する
+// IMEのないキーボードで入力できるように長音は記号を使わず、パスポートと同じく省略する
+package romaji
+
+import (
+	"strings"
+
+	"github.com/mitsu-yuki/shisho-backend/pkg/text"
+)
+
+// syllables はカナ1文字分のローマ字
+var syllables = map[rune]string{
+	'ア': "a", 'イ': "i", 'ウ': "u", 'エ': "e", 'オ': "o",
+	'カ': "ka", 'キ': "ki", 'ク': "ku", 'ケ': "ke", 'コ': "ko",
+	'サ': "sa", 'シ': "shi", 'ス': "su", 'セ': "se", 'ソ': "so",
+	'タ': "ta", 'チ': "chi", 'ツ': "tsu", 'テ': "te", 'ト': "to",
+	'ナ': "na", 'ニ': "ni", 'ヌ': "nu", 'ネ': "ne", 'ノ': "no",
+	'ハ': "ha", 'ヒ': "hi", 'フ': "fu", 'ヘ': "he", 'ホ': "ho",
+	'マ': "ma", 'ミ': "mi", 'ム': "mu", 'メ': "me", 'モ': "mo",
+	'ヤ': "ya", 'ユ': "yu", 'ヨ': "yo",
+	'ラ': "ra", 'リ': "ri", 'ル': "ru", 'レ': "re", 'ロ': "ro",
+	'ワ': "wa", 'ヰ': "i", 'ヱ': "e", 'ヲ': "o", 'ン': "n",
+	'ガ': "ga", 'ギ': "gi", 'グ': "gu", 'ゲ': "ge", 'ゴ': "go",
+	'ザ': "za", 'ジ': "ji", 'ズ': "zu", 'ゼ': "ze", 'ゾ': "zo",
+	'ダ': "da", 'ヂ': "ji", 'ヅ': "zu", 'デ': "de", 'ド': "do",
+	'バ': "ba", 'ビ': "bi", 'ブ': "bu", 'ベ': "be", 'ボ': "bo",
+	'パ': "pa", 'ピ': "pi", 'プ': "pu", 'ペ': "pe", 'ポ': "po",
+	'ヴ': "vu", 'ヷ': "va", 'ヸ': "vi", 'ヹ': "ve", 'ヺ': "vo",
+	'ァ': "a", 'ィ': "i", 'ゥ': "u", 'ェ': "e", 'ォ': "o",
+	'ャ': "ya", 'ュ': "yu", 'ョ': "yo", 'ヮ': "wa", 'ヵ': "ka", 'ヶ': "ke",
+}
+
+// digraphs は拗音や外来語の表記のように2文字で1音になるカナのローマ字
+var digraphs = map[string]string{
+	"キャ": "kya", "キュ": "kyu", "キョ": "kyo",
+	"シャ": "sha", "シュ": "shu", "ショ": "sho", "シェ": "she",
+	"チャ": "cha", "チュ": "chu", "チョ": "cho", "チェ": "che",
+	"ニャ": "nya", "ニュ": "nyu", "ニョ": "nyo",
+	"ヒャ": "hya", "ヒュ": "hyu", "ヒョ": "hyo",
+	"ミャ": "mya", "ミュ": "myu", "ミョ": "myo",
+	"リャ": "rya", "リュ": "ryu", "リョ": "ryo",
+	"ギャ": "gya", "ギュ": "gyu", "ギョ": "gyo",
+	"ジャ": "ja", "ジュ": "ju", "ジョ": "jo", "ジェ": "je",
+	"ヂャ": "ja", "ヂュ": "ju", "ヂョ": "jo",
+	"ビャ": "bya", "ビュ": "byu", "ビョ": "byo",
+	"ピャ": "pya", "ピュ": "pyu", "ピョ": "pyo",
+	"ファ": "fa", "フィ": "fi", "フェ": "fe", "フォ": "fo", "フュ": "fyu",
+	"ヴァ": "va", "ヴィ": "vi", "ヴェ": "ve", "ヴォ": "vo",
+	"ウィ": "wi", "ウェ": "we", "ウォ": "wo",
+	"ティ": "ti", "テュ": "tyu", "トゥ": "tu",
+	"ディ": "di", "デュ": "dyu", "ドゥ": "du",
+	"ツァ": "tsa", "ツィ": "tsi", "ツェ": "tse", "ツォ": "tso",
+	"イェ": "ye", "クァ": "kwa", "グァ": "gwa",
+}
+
+const (
+	sokuon    = 'ッ'
+	longVowel = 'ー'
+	// separator は読みの中の空白、中黒、二重ハイフンの代わりに入れる
+	separator = " "
+)
+
+// FromKatakana はカタカナの読みをローマ字に変換する
+// 読みは text.NormalizeReading で揃えてから変換し、単語の区切りは半角の空白1つにする
+// 促音は次の子音を重ね、長音は長音符も母音の重なりも省略する(オオタ→ota、ユウキ→yuki)
+func FromKatakana(s string) string {
+	kana := []rune(text.NormalizeReading(s))
+	var b strings.Builder
+	// pending は促音の後で次の子音を待っているか
+	pending := false
+	for i := 0; i < len(kana); i++ {
+		r := kana[i]
+		var syllable string
+		switch {
+		case r == sokuon:
+			pending = true
+			continue
+		case r == longVowel:
+			continue
+		case r == '　' || r == '・' || r == '＝':
+			if b.Len() > 0 && !strings.HasSuffix(b.String(), separator) {
+				b.WriteString(separator)
+			}
+			pending = false
+			continue
+		case r == 'ヽ' || r == 'ヾ':
+			// 踊り字は直前のカナを繰り返す
+			if i == 0 {
+				continue
+			}
+			prev := kana[i-1]
+			if r == 'ヾ' && strings.ContainsRune("カキクケコサシスセソタチツテトハヒフヘホ", prev) {
+				// 清音の次のコードポイントが濁音
+				prev++
+			}
+			syllable = syllables[prev]
+		default:
+			if i+1 < len(kana) {
+				if d, ok := digraphs[string(kana[i:i+2])]; ok {
+					syllable = d
+					i++
+					break
+				}
+			}
+			syllable = syllables[r]
+		}
+		if syllable == "" {
+			continue
+		}
+
+		if pending {
+			pending = false
+			switch {
+			case strings.HasPrefix(syllable, "ch"):
+				b.WriteByte('t')
+			case !isVowel(syllable[0]):
+				b.WriteByte(syllable[0])
+			}
+		}
+		b.WriteString(syllable)
+	}
+	return Fold(strings.TrimSuffix(b.String(), separator))
+}
+
+// Fold は長音にあたる母音の重なりを省略する
+// ou と oo は o に、uu は u にする。ローマ字で入力された検索語を揃えるのにも使う
+func Fold(s string) string {
+	var b strings.Builder
+	var prev byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c == 'u' && (prev == 'o' || prev == 'u')) || (c == 'o' && prev == 'o') {
+			continue
+		}
+		b.WriteByte(c)
+		prev = c
+	}
+	return b.String()
+}
+
+// NormalizeQuery はローマ字で入力された検索語を FromKatakana の結果と比べられる形にする
+// 小文字にして英数字以外を取り除き、長音記号付きの母音や撥音の m も揃える
+// 空白やハイフンの区切りも取り除くため、比べる側も区切りを除いておく
+func NormalizeQuery(q string) string {
+	var words []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(q), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '　' || r == '_'
+	}) {
+		var b strings.Builder
+		for _, r := range word {
+			switch r {
+			case 'ā', 'â':
+				r = 'a'
+			case 'ī', 'î':
+				r = 'i'
+			case 'ū', 'û':
+				r = 'u'
+			case 'ē', 'ê':
+				r = 'e'
+			case 'ō', 'ô':
+				r = 'o'
+			}
+			if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+				b.WriteRune(r)
+			}
+		}
+		// 撥音をmで書くヘボン式(shimbun、homma)もnに揃える
+		w := b.String()
+		w = strings.NewReplacer("mb", "nb", "mp", "np", "mm", "nm").Replace(w)
+		words = append(words, Fold(w))
+	}
+	return strings.Join(words, "")
+}
+
+// Slug はローマ字をURLに使える形にする
+// 単語の区切りはハイフンにする(オダ エイイチロウ→oda-eiichiro)
+func Slug(romaji string) string {
+	return strings.Join(strings.Fields(romaji), "-")
+}
+
+// FromSlug は Slug で作った文字列をローマ字に戻す
+func FromSlug(slug string) string {
+	return strings.ReplaceAll(strings.ToLower(slug), "-", separator)
+}
+
+func isVowel(c byte) bool {
+	return strings.IndexByte("aiueo", c) >= 0
+}
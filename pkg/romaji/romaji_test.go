@@ -0,0 +1,106 @@
+package romaji
+
+import "testing"
+
+func TestFromKatakana(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "正常系", s: "ナツメ ソウセキ", want: "natsume soseki"},
+		{name: "正常系: 拗音", s: "キョウト", want: "kyoto"},
+		{name: "正常系: 促音は次の子音を重ねる", s: "ハットリ", want: "hattori"},
+		{name: "正常系: チの前の促音はtにする", s: "マッチャ", want: "matcha"},
+		{name: "正常系: チャ行以外のチの前の促音", s: "ボッチ", want: "botchi"},
+		{name: "正常系: 撥音はbの前でもnにする", s: "シンブン", want: "shinbun"},
+		{name: "正常系: 撥音はmの前でもnにする", s: "ホンマ", want: "honma"},
+		{name: "正常系: 撥音はpの前でもnにする", s: "シンペイ", want: "shinpei"},
+		{name: "正常系: 長音符は省略する", s: "ジョージ・オーウェル", want: "joji oweru"},
+		{name: "正常系: ouとooとuuは省略する", s: "オオタ ユウキ コウタ", want: "ota yuki kota"},
+		{name: "正常系: eiとiiは省略しない", s: "エイイチロウ", want: "eiichiro"},
+		{name: "正常系: ひらがなと半角カナ", s: "ｻｲﾄｳ たかし", want: "saito takashi"},
+		{name: "正常系: 踊り字", s: "イスヾ", want: "isuzu"},
+		{name: "正常系: 区切りが続いても1つにする", s: "アレクサンドル＝・デュマ", want: "arekusandoru dyuma"},
+		{name: "正常系: 末尾の促音は無視する", s: "アッ", want: "a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromKatakana(tt.s); got != tt.want {
+				t.Errorf("FromKatakana(%q) = %q, want = %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "正常系: ou", s: "tokyou", want: "tokyo"},
+		{name: "正常系: oo", s: "ootani", want: "otani"},
+		{name: "正常系: uu", s: "yuuki", want: "yuki"},
+		{name: "正常系: 3つ以上の重なり", s: "ooou", want: "o"},
+		{name: "正常系: aaとeeとiiはそのままにする", s: "okaasan oneesan niigata", want: "okaasan oneesan niigata"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Fold(tt.s); got != tt.want {
+				t.Errorf("Fold(%q) = %q, want = %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		q    string
+		want string
+	}{
+		{name: "正常系", q: "natsume", want: "natsume"},
+		{name: "正常系: 大文字と区切り", q: "Natsume Soseki", want: "natsumesoseki"},
+		{name: "正常系: ハイフンとアンダースコア", q: "oda-eiichiro_", want: "odaeiichiro"},
+		{name: "正常系: 母音を重ねた長音", q: "souseki", want: "soseki"},
+		{name: "正常系: マクロン", q: "Sōseki", want: "soseki"},
+		{name: "正常系: サーカムフレックス", q: "sôseki", want: "soseki"},
+		{name: "正常系: bの前のm", q: "shimbun", want: "shinbun"},
+		{name: "正常系: mの前のm", q: "homma", want: "honma"},
+		{name: "正常系: pの前のm", q: "shimpei", want: "shinpei"},
+		{name: "正常系: 英数字以外は除く", q: "oda!?", want: "oda"},
+		{name: "正常系: 空文字", q: "  ", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeQuery(tt.q); got != tt.want {
+				t.Errorf("NormalizeQuery(%q) = %q, want = %q", tt.q, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		romaji   string
+		want     string
+		wantBack string
+	}{
+		{name: "正常系", romaji: "oda eiichiro", want: "oda-eiichiro", wantBack: "oda eiichiro"},
+		{name: "正常系: 区切りなし", romaji: "clamp", want: "clamp", wantBack: "clamp"},
+		{name: "正常系: 連続する空白", romaji: " joji  oweru ", want: "joji-oweru", wantBack: "joji oweru"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Slug(tt.romaji)
+			if got != tt.want {
+				t.Errorf("Slug(%q) = %q, want = %q", tt.romaji, got, tt.want)
+			}
+			if back := FromSlug(got); back != tt.wantBack {
+				t.Errorf("FromSlug(%q) = %q, want = %q", got, back, tt.wantBack)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+)
+
+// oldKanji は旧字体・異体字と新字体の対応表
+// 人名や社名の表記揺れでよく見るものに限る
+var oldKanji = map[rune]rune{
+	'亞': '亜', '惡': '悪', '壓': '圧', '圍': '囲', '醫': '医', '榮': '栄', '衞': '衛', '驛': '駅',
+	'圓': '円', '鹽': '塩', '應': '応', '櫻': '桜', '假': '仮', '價': '価', '會': '会', '繪': '絵',
+	'擴': '拡', '覺': '覚', '學': '学', '樂': '楽', '氣': '気', '歸': '帰', '舊': '旧', '據': '拠',
+	'擧': '挙', '峽': '峡', '狹': '狭', '曉': '暁', '區': '区', '驅': '駆', '勳': '勲', '徑': '径',
+	'惠': '恵', '經': '経', '藝': '芸', '缺': '欠', '劍': '剣', '儉': '倹', '權': '権', '顯': '顕',
+	'驗': '験', '嚴': '厳', '廣': '広', '恆': '恒', '黃': '黄', '國': '国', '黑': '黒', '濟': '済',
+	'齋': '斎', '齊': '斉', '﨑': '崎', '碕': '崎', '雜': '雑', '參': '参', '棧': '桟', '蠶': '蚕',
+	'絲': '糸', '齒': '歯', '兒': '児', '實': '実', '寫': '写', '壽': '寿', '收': '収', '從': '従',
+	'澁': '渋', '獸': '獣', '肅': '粛', '處': '処', '敍': '叙', '將': '将', '燒': '焼', '獎': '奨',
+	'條': '条', '狀': '状', '乘': '乗', '淨': '浄', '剩': '剰', '疊': '畳', '讓': '譲', '釀': '醸',
+	'觸': '触', '眞': '真', '愼': '慎', '盡': '尽', '圖': '図', '粹': '粋', '醉': '酔', '隨': '随',
+	'數': '数', '聲': '声', '靜': '静', '竊': '窃', '專': '専', '淺': '浅', '錢': '銭', '潛': '潜',
+	'禪': '禅', '雙': '双', '壯': '壮', '爭': '争', '莊': '荘', '搜': '捜', '總': '総', '聰': '聡',
+	'藏': '蔵', '臟': '臓', '屬': '属', '續': '続', '墮': '堕', '對': '対', '體': '体', '帶': '帯',
+	'滯': '滞', '臺': '台', '瀧': '滝', '擇': '択', '澤': '沢', '單': '単', '團': '団', '斷': '断',
+	'癡': '痴', '遲': '遅', '晝': '昼', '蟲': '虫', '鑄': '鋳', '廳': '庁', '聽': '聴', '鎭': '鎮',
+	'遞': '逓', '鐵': '鉄', '轉': '転', '點': '点', '傳': '伝', '黨': '党', '盜': '盗', '燈': '灯',
+	'當': '当', '鬭': '闘', '德': '徳', '獨': '独', '讀': '読', '屆': '届', '貳': '弐', '腦': '脳',
+	'霸': '覇', '拜': '拝', '賣': '売', '麥': '麦', '發': '発', '髮': '髪', '拔': '抜', '蠻': '蛮',
+	'祕': '秘', '濱': '浜', '甁': '瓶', '拂': '払', '佛': '仏', '竝': '並', '變': '変', '邊': '辺',
+	'邉': '辺', '辨': '弁', '瓣': '弁', '辯': '弁', '寶': '宝', '豐': '豊', '沒': '没', '飜': '翻',
+	'萬': '万', '滿': '満', '默': '黙', '彌': '弥', '譯': '訳', '藥': '薬', '與': '与', '豫': '予',
+	'餘': '余', '譽': '誉', '搖': '揺', '樣': '様', '謠': '謡', '來': '来', '賴': '頼', '亂': '乱',
+	'覽': '覧', '龍': '竜', '兩': '両', '獵': '猟', '綠': '緑', '壘': '塁', '勵': '励', '禮': '礼',
+	'隸': '隷', '靈': '霊', '齡': '齢', '戀': '恋', '爐': '炉', '勞': '労', '樓': '楼', '錄': '録',
+	'灣': '湾', '髙': '高', '嶋': '島', '嶌': '島', '槗': '橋', '瀨': '瀬', '冨': '富',
+}
+
+// NormalizeName は名前やタイトルの表記揺れを比べるための文字列にする
+// 全角英数字と半角カタカナは幅を揃え、英字は小文字にし、旧字体は新字体にする
+// 空白と中黒などの区切りは取り除く
+func NormalizeName(s string) string {
+	var b strings.Builder
+	for _, r := range foldKanaWidth(s) {
+		switch {
+		case unicode.IsSpace(r), r == middleDot, r == doubleHyphen, r == '=', r == '·':
+			continue
+		case r >= '！' && r <= '～':
+			r -= '！' - '!'
+		}
+		if n, ok := oldKanji[r]; ok {
+			r = n
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// foldKanaWidth は半角カタカナを全角にして濁点と半濁点を合成する
+// NormalizeReading と異なり、ひらがなや空白はそのままにする
+func foldKanaWidth(s string) string {
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r >= 'ｦ' && r <= 'ﾝ':
+			r = halfWidthKatakana[r-'ｦ']
+		case r == '･':
+			r = middleDot
+		}
+		if mark := soundMark(r); mark != 0 && len(out) > 0 {
+			if composed, ok := compose(out[len(out)-1], mark); ok {
+				out[len(out)-1] = composed
+				continue
+			}
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
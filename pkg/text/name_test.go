@@ -0,0 +1,30 @@
+package text
+
+import "testing"
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "正常系", s: "夏目漱石", want: "夏目漱石"},
+		{name: "正常系: 空白を除く", s: "夏目 漱石　", want: "夏目漱石"},
+		{name: "正常系: 旧字体を新字体にする", s: "澤村 伊智", want: "沢村伊智"},
+		{name: "正常系: 異体字を揃える", s: "髙橋 邉", want: "高橋辺"},
+		{name: "正常系: 全角英数字を半角の小文字にする", s: "ＣＬＡＭＰ　２", want: "clamp2"},
+		{name: "正常系: 英字を小文字にする", s: "Square Enix", want: "squareenix"},
+		{name: "正常系: 半角カナを全角にして濁点を合成する", s: "ｼﾞｬﾝﾌﾟ", want: "ジャンプ"},
+		{name: "正常系: 中黒と二重ハイフンを除く", s: "ジャンプ・コミックス ｱﾚｸｻﾝﾄﾞﾙ=ﾃﾞｭﾏ", want: "ジャンプコミックスアレクサンドルデュマ"},
+		{name: "正常系: 半角の中黒を除く", s: "ｼﾞｬﾝﾌﾟ･ｺﾐｯｸｽ", want: "ジャンプコミックス"},
+		{name: "正常系: ひらがなとカタカナは区別する", s: "なつめ", want: "なつめ"},
+		{name: "正常系: 空文字", s: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeName(tt.s); got != tt.want {
+				t.Errorf("NormalizeName(%q) = %q, want = %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// readingSpace は読みの中の空白。連続する空白は1つにまとめる
+	readingSpace = '　'
+	longVowel    = 'ー'
+	middleDot    = '・'
+	doubleHyphen = '＝'
+)
+
+// halfWidthKatakana は半角カタカナと全角カタカナの対応表
+// ｦ(U+FF66)からﾝ(U+FF9D)までを順に並べる
+var halfWidthKatakana = []rune("ヲァィゥェォャュョッーアイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン")
+
+// NormalizeReading は読みをカタカナに揃える
+// ひらがなと半角カタカナを全角カタカナにし、濁点と半濁点を前の文字と合成する
+// 前後の空白は取り除き、間の空白は全角の空白1つにまとめる
+func NormalizeReading(s string) string {
+	out := make([]rune, 0, len(s))
+	space := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			space = len(out) > 0
+			continue
+		}
+		if space {
+			out = append(out, readingSpace)
+			space = false
+		}
+
+		switch {
+		case r >= 'ｦ' && r <= 'ﾝ':
+			r = halfWidthKatakana[r-'ｦ']
+		case r == '･':
+			r = middleDot
+		case r == '=':
+			r = doubleHyphen
+		case r >= 'ぁ' && r <= 'ゖ', r == 'ゝ' || r == 'ゞ':
+			r += 'ァ' - 'ぁ'
+		}
+
+		if mark := soundMark(r); mark != 0 && len(out) > 0 {
+			if composed, ok := compose(out[len(out)-1], mark); ok {
+				out[len(out)-1] = composed
+				continue
+			}
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// soundMark は濁点なら '゛'、半濁点なら '゜' を返す
+// 結合文字と半角の濁点、半濁点も同じものとして扱う
+func soundMark(r rune) rune {
+	switch r {
+	case '゛', 'ﾞ', '゙':
+		return '゛'
+	case '゜', 'ﾟ', '゚':
+		return '゜'
+	}
+	return 0
+}
+
+// compose はカタカナに濁点か半濁点を合成する
+func compose(r rune, mark rune) (rune, bool) {
+	switch {
+	case r >= 'ハ' && r <= 'ホ' && (r-'ハ')%3 == 0:
+		if mark == '゛' {
+			return r + 1, true
+		}
+		return r + 2, true
+	case mark != '゛':
+		return 0, false
+	case strings.ContainsRune("カキクケコサシスセソタチツテト", r):
+		return r + 1, true
+	case r == 'ウ':
+		return 'ヴ', true
+	case r >= 'ワ' && r <= 'ヲ':
+		return r + 'ヷ' - 'ワ', true
+	case r == 'ヽ':
+		return 'ヾ', true
+	}
+	return 0, false
+}
+
+// IsReading は読みとして使える文字だけでできているか調べる
+// 全角カタカナのほかに長音符、中黒、二重ハイフン、全角の空白を使える
+// 記号だけの文字列は読みとみなさない
+func IsReading(s string) bool {
+	kana := false
+	for _, r := range s {
+		switch {
+		case r >= 'ァ' && r <= 'ヺ', r == 'ヽ' || r == 'ヾ':
+			kana = true
+		case r == longVowel, r == middleDot, r == doubleHyphen, r == readingSpace:
+		default:
+			return false
+		}
+	}
+	return kana
+}
@@ -0,0 +1,87 @@
+package text
+
+import "testing"
+
+func TestNormalizeReading(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "正常系: ひらがなをカタカナにする",
+			s:    "なつめ そうせき",
+			want: "ナツメ　ソウセキ",
+		},
+		{
+			name: "正常系: 前後の空白を除き、間の空白をまとめる",
+			s:    " ﾑﾗｶﾐ \t ﾊﾙｷ ",
+			want: "ムラカミ　ハルキ",
+		},
+		{
+			name: "正常系: 半角の濁点を合成する",
+			s:    "ｶﾞﾝﾀﾞﾑ",
+			want: "ガンダム",
+		},
+		{
+			name: "正常系: 半角の半濁点を合成する",
+			s:    "ﾎﾟｹｯﾄ",
+			want: "ポケット",
+		},
+		{
+			name: "正常系: 結合文字の濁点と半濁点を合成する",
+			s:    "ヴパ",
+			want: "ヴパ",
+		},
+		{
+			name: "正常系: ワ行の濁音",
+			s:    "ﾜﾞ",
+			want: "ヷ",
+		},
+		{
+			name: "正常系: 小書きと長音符",
+			s:    "ｼﾞｮｰｼﾞ･ｵｰｳｪﾙ",
+			want: "ジョージ・オーウェル",
+		},
+		{
+			name: "正常系: 二重ハイフンと踊り字",
+			s:    "ｱﾚｸｻﾝﾄﾞﾙ=ﾃﾞｭﾏ いすゞ",
+			want: "アレクサンドル＝デュマ　イスヾ",
+		},
+		{
+			name: "正常系: 濁点が付かない文字はそのままにする",
+			s:    "ﾏﾞ",
+			want: "マﾞ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeReading(tt.s); got != tt.want {
+				t.Errorf("NormalizeReading(%q) = %q, want = %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsReading(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{name: "正常系", s: "ナツメ　ソウセキ", want: true},
+		{name: "正常系: 長音符と中黒", s: "ジョージ・オーウェル", want: true},
+		{name: "正常系: 踊り字", s: "イスヾ", want: true},
+		{name: "異常系: ひらがな", s: "なつめ", want: false},
+		{name: "異常系: 半角の空白", s: "ナツメ ソウセキ", want: false},
+		{name: "異常系: 記号だけ", s: "ー・", want: false},
+		{name: "異常系: 空文字", s: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsReading(tt.s); got != tt.want {
+				t.Errorf("IsReading(%q) = %v, want = %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}